@@ -3,6 +3,7 @@ package sqlformatter
 type NodeType string
 
 const (
+	NodeScript                NodeType = "script"
 	NodeStatement             NodeType = "statement"
 	NodeClause                NodeType = "clause"
 	NodeSetOperation          NodeType = "set_operation"
@@ -15,6 +16,8 @@ const (
 	NodeCaseExpression        NodeType = "case_expression"
 	NodeCaseWhen              NodeType = "case_when"
 	NodeCaseElse              NodeType = "case_else"
+	NodeBlock                 NodeType = "block"
+	NodeBlockSection          NodeType = "block_section"
 	NodeLimitClause           NodeType = "limit_clause"
 	NodeAllColumnsAsterisk    NodeType = "all_columns_asterisk"
 	NodeLiteral               NodeType = "literal"
@@ -29,18 +32,40 @@ const (
 	NodeDisableComment        NodeType = "disable_comment"
 )
 
-type AstNode interface{}
+// AstNode is a node of the syntax tree built by the parser. The concrete
+// node types are the *...Node structs in this file.
+type AstNode interface {
+	Kind() NodeType
+}
+
+// Position is a location in the parsed source. Offset is in bytes; Line and
+// Column are 1-based, and Column counts runes.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
 
 type BaseNode struct {
 	LeadingComments  []CommentNode
 	TrailingComments []CommentNode
+	// Start is the position of the node's first token and End the position
+	// just past its last one. Comments attached to the node itself, as
+	// LeadingComments or TrailingComments, are outside this span.
+	Start Position
+	End   Position
 }
 
+// StatementNode is a single statement. Its span runs from its first token,
+// including any comments before it, up to and including its semicolon.
 type StatementNode struct {
 	BaseNode
 	Type         NodeType
 	Children     []AstNode
 	HasSemicolon bool
+	// BatchSeparator marks a statement that consists only of a batch
+	// separator line, such as GO in T-SQL scripts.
+	BatchSeparator bool
 }
 
 type ClauseNode struct {
@@ -128,6 +153,24 @@ type CaseElseNode struct {
 	Result []AstNode
 }
 
+// BlockNode is a procedural block such as PL/SQL's DECLARE ... BEGIN ...
+// EXCEPTION ... END. Statements inside the block end with their own
+// semicolons without ending the enclosing statement.
+type BlockNode struct {
+	BaseNode
+	Type     NodeType
+	Sections []*BlockSectionNode
+	EndKw    KeywordNode
+	Label    *IdentifierNode
+}
+
+type BlockSectionNode struct {
+	BaseNode
+	Type       NodeType
+	NameKw     KeywordNode
+	Statements []*StatementNode
+}
+
 type LimitClauseNode struct {
 	BaseNode
 	Type    NodeType
@@ -208,4 +251,32 @@ type DisableCommentNode struct {
 	PrecedingWhitespace string
 }
 
-type CommentNode interface{}
+// CommentNode is a *LineCommentNode, *BlockCommentNode or *DisableCommentNode.
+type CommentNode = AstNode
+
+func (n *StatementNode) Kind() NodeType             { return n.Type }
+func (n *ClauseNode) Kind() NodeType                { return n.Type }
+func (n *SetOperationNode) Kind() NodeType          { return n.Type }
+func (n *FunctionCallNode) Kind() NodeType          { return n.Type }
+func (n *ParameterizedDataTypeNode) Kind() NodeType { return n.Type }
+func (n *ArraySubscriptNode) Kind() NodeType        { return n.Type }
+func (n *PropertyAccessNode) Kind() NodeType        { return n.Type }
+func (n *ParenthesisNode) Kind() NodeType           { return n.Type }
+func (n *BetweenPredicateNode) Kind() NodeType      { return n.Type }
+func (n *CaseExpressionNode) Kind() NodeType        { return n.Type }
+func (n *CaseWhenNode) Kind() NodeType              { return n.Type }
+func (n *CaseElseNode) Kind() NodeType              { return n.Type }
+func (n *BlockNode) Kind() NodeType                 { return n.Type }
+func (n *BlockSectionNode) Kind() NodeType          { return n.Type }
+func (n *LimitClauseNode) Kind() NodeType           { return n.Type }
+func (n *AllColumnsAsteriskNode) Kind() NodeType    { return n.Type }
+func (n *LiteralNode) Kind() NodeType               { return n.Type }
+func (n *IdentifierNode) Kind() NodeType            { return n.Type }
+func (n *DataTypeNode) Kind() NodeType              { return n.Type }
+func (n *KeywordNode) Kind() NodeType               { return n.Type }
+func (n *ParameterNode) Kind() NodeType             { return n.Type }
+func (n *OperatorNode) Kind() NodeType              { return n.Type }
+func (n *CommaNode) Kind() NodeType                 { return n.Type }
+func (n *LineCommentNode) Kind() NodeType           { return n.Type }
+func (n *BlockCommentNode) Kind() NodeType          { return n.Type }
+func (n *DisableCommentNode) Kind() NodeType        { return n.Type }
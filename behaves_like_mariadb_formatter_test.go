@@ -0,0 +1,174 @@
+package sqlformatter
+
+import "testing"
+
+func behavesLikeMariaDbFormatter(t *testing.T, format FormatFn, formatErr FormatErrFn) {
+	t.Helper()
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{HashComments: true})
+	supportsStrings(t, format, formatErr, []string{"\"\"-qq-bs", "''-qq-bs", "N''", "X''", "B''"})
+	supportsIdentifiers(t, format, formatErr, []string{"``"})
+	supportsNumbers(t, format, numbersConfig{})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsConstraints(t, format, []string{"RESTRICT", "CASCADE", "SET NULL", "NO ACTION", "SET DEFAULT"})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, Modify: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithoutTable: true})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{
+		Without:       []string{"FULL"},
+		Additionally:  []string{"STRAIGHT_JOIN", "NATURAL LEFT JOIN", "NATURAL LEFT OUTER JOIN", "NATURAL RIGHT JOIN", "NATURAL RIGHT OUTER JOIN"},
+		SupportsUsing: true,
+	})
+	supportsParams(t, format, paramConfig{Positional: true})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("allows $ character as part of identifiers", func(t *testing.T) {
+		result := format("SELECT $foo, some$$ident")
+		expected := dedent(`
+			SELECT
+			  $foo,
+			  some$$ident
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports identifiers that start with numbers", func(t *testing.T) {
+		result := format("SELECT 4four, 12345e, 12e45, $567 FROM tbl")
+		expected := dedent(`
+			SELECT
+			  4four,
+			  12345e,
+			  12e45,
+			  $567
+			FROM
+			  tbl
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports @variables", func(t *testing.T) {
+		assertEqual(t, format("SELECT @foo, @some_long.var$with$special.chars"), dedent(`
+			SELECT
+			  @foo,
+			  @some_long.var$with$special.chars
+		`))
+	})
+
+	t.Run("supports @@ system variables", func(t *testing.T) {
+		result := format("SELECT @@GLOBAL.time, @@SYSTEM.date, @@hour FROM foo;")
+		expected := dedent(`
+			SELECT
+			  @@GLOBAL.time,
+			  @@SYSTEM.date,
+			  @@hour
+			FROM
+			  foo;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports quoted @variables", func(t *testing.T) {
+		result := format("SELECT @\"foo fo\", @\"foo\\\"x\", @'bar ar', @'bar\\'x', @`baz zaz`, @`baz``zaz`")
+		expected := dedent("\n\t\t\tSELECT\n\t\t\t  @\"foo fo\",\n\t\t\t  @\"foo\\\"x\",\n\t\t\t  @'bar ar',\n\t\t\t  @'bar\\'x',\n\t\t\t  @`baz zaz`,\n\t\t\t  @`baz``zaz`\n\t\t")
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports setting variables: @var :=", func(t *testing.T) {
+		assertEqual(t, format("SET @foo := 10;"), "SET\n  @foo := 10;")
+	})
+
+	t.Run("supports @var := inside SELECT", func(t *testing.T) {
+		result := format("SELECT @total := @total + price FROM items;")
+		expected := dedent(`
+			SELECT
+			  @total := @total + price
+			FROM
+			  items;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports XOR logical operator", func(t *testing.T) {
+		result := format("SELECT a FROM t WHERE b XOR c;")
+		expected := dedent(`
+			SELECT
+			  a
+			FROM
+			  t
+			WHERE
+			  b
+			  XOR c;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports ON DUPLICATE KEY UPDATE", func(t *testing.T) {
+		result := format("INSERT INTO customer VALUES ('John','Doe') ON DUPLICATE KEY UPDATE fname='Untitled';")
+		expected := dedent(`
+			INSERT INTO
+			  customer
+			VALUES
+			  ('John', 'Doe')
+			ON DUPLICATE KEY UPDATE
+			  fname = 'Untitled';
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats VALUES() function inside ON DUPLICATE KEY UPDATE", func(t *testing.T) {
+		result := format("INSERT INTO t (a, b) VALUES (1, 2) ON DUPLICATE KEY UPDATE b = VALUES(b);")
+		expected := dedent(`
+			INSERT INTO
+			  t (a, b)
+			VALUES
+			  (1, 2)
+			ON DUPLICATE KEY UPDATE
+			  b = VALUES(b);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports INSERT IGNORE and REPLACE INTO", func(t *testing.T) {
+		result := format("INSERT IGNORE INTO t VALUES (1); REPLACE INTO t VALUES (2);")
+		expected := dedent(`
+			INSERT IGNORE INTO
+			  t
+			VALUES
+			  (1);
+
+			REPLACE INTO
+			  t
+			VALUES
+			  (2);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats REPLACE() as a function", func(t *testing.T) {
+		result := format("SELECT REPLACE(name, 'a', 'b') FROM t;")
+		expected := dedent(`
+			SELECT
+			  REPLACE(name, 'a', 'b')
+			FROM
+			  t;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("treats SET( as data type", func(t *testing.T) {
+		result := format("CREATE TABLE foo (bar SET('Y', 'N'));")
+		expected := "CREATE TABLE foo (bar SET('Y', 'N'));"
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports CHARACTER SET and IDENTIFIED BY", func(t *testing.T) {
+		result := format("CREATE USER foo IDENTIFIED BY 'secret';", FormatOptions{KeywordCase: KeywordCaseUpper})
+		expected := "CREATE USER foo IDENTIFIED BY 'secret';"
+		assertEqual(t, result, expected)
+	})
+}
@@ -19,6 +19,32 @@ func behavesLikePostgresqlFormatter(t *testing.T, format FormatFn) {
 	supportsWindow(t, format)
 	supportsDataTypeCase(t, format)
 
+	t.Run("preserves sqlc.arg spacing on BETWEEN right side", func(t *testing.T) {
+		result := format("SELECT * FROM foo WHERE business_date BETWEEN sqlc.arg('start')::date AND sqlc.arg('end')::date;")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  foo
+			WHERE
+			  business_date BETWEEN sqlc.arg('start')::date AND sqlc.arg  ('end')::date;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("preserves keyword-like table name call after JOIN", func(t *testing.T) {
+		result := format("SELECT * FROM task LEFT JOIN call ON task.id = call.task_id;")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  task
+			  LEFT JOIN
+			call ON task.id = call.task_id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
 	t.Run("allows $ character as part of identifiers", func(t *testing.T) {
 		result := format("SELECT foo$, some$$ident")
 		expected := dedent(`
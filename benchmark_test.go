@@ -9,11 +9,16 @@ var benchmarkSink string
 
 func benchmarkFormat(b *testing.B, sql string, opts ...FormatOptions) {
 	b.Helper()
-	b.ReportAllocs()
 	cfg := FormatOptionsWithLanguage{Language: LanguagePostgresql}
 	if len(opts) > 0 {
 		cfg.FormatOptions = opts[0]
 	}
+	benchmarkFormatConfig(b, sql, cfg)
+}
+
+func benchmarkFormatConfig(b *testing.B, sql string, cfg FormatOptionsWithLanguage) {
+	b.Helper()
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		out, err := Format(sql, cfg)
@@ -122,3 +127,18 @@ func BenchmarkFormat_PostgresWideInserts(b *testing.B) {
 		strings.TrimSuffix(values, ",") + ";"
 	benchmarkFormat(b, sql)
 }
+
+func BenchmarkFormat_MysqlManyStatements(b *testing.B) {
+	stmt := "SELECT id, name, @@session.sql_mode FROM users WHERE id = @id AND name LIKE ?;"
+	benchmarkFormatConfig(b, strings.Repeat(stmt+"\n", 200), FormatOptionsWithLanguage{Language: LanguageMysql})
+}
+
+func BenchmarkFormat_BigqueryManyStatements(b *testing.B) {
+	stmt := "SELECT id, name, @@project_id FROM `proj.ds.users` WHERE id = @id AND name LIKE ?;"
+	benchmarkFormatConfig(b, strings.Repeat(stmt+"\n", 200), FormatOptionsWithLanguage{Language: LanguageBigquery})
+}
+
+func BenchmarkFormat_PlsqlManyStatements(b *testing.B) {
+	stmt := "SELECT id, name FROM users WHERE id = &user_id AND name LIKE &&name_pattern;"
+	benchmarkFormatConfig(b, strings.Repeat(stmt+"\n", 200), FormatOptionsWithLanguage{Language: LanguagePlsql})
+}
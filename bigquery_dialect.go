@@ -0,0 +1,247 @@
+package sqlformatter
+
+import (
+	"strings"
+
+	bigquery "sql-formatter-go/languages/bigquery"
+)
+
+var bigqueryReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT] [AS STRUCT | AS VALUE]",
+}
+
+var bigqueryReservedClausesPhrases = []string{
+	"WITH [RECURSIVE]",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"QUALIFY",
+	"WINDOW",
+	"PARTITION BY",
+	"ORDER BY",
+	"LIMIT",
+	"OFFSET",
+	"OMIT RECORD IF",
+	"INSERT [INTO]",
+	"VALUES",
+	"SET",
+	"MERGE [INTO]",
+	"WHEN [NOT] MATCHED [BY SOURCE | BY TARGET] [THEN]",
+	"UPDATE SET",
+	"INSERT ROW",
+	"CLUSTER BY",
+	"FOR SYSTEM_TIME AS OF",
+	"WITH CONNECTION",
+	"WITH PARTITION COLUMNS",
+	"REMOTE WITH CONNECTION",
+}
+
+var bigqueryStandardOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [TEMP | TEMPORARY | SNAPSHOT | EXTERNAL] TABLE [IF NOT EXISTS]",
+}
+
+var bigqueryTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [MATERIALIZED] VIEW [IF NOT EXISTS]",
+	"UPDATE",
+	"DELETE [FROM]",
+	"DROP [SNAPSHOT | EXTERNAL] TABLE [IF EXISTS]",
+	"ALTER TABLE [IF EXISTS]",
+	"ADD COLUMN [IF NOT EXISTS]",
+	"DROP COLUMN [IF EXISTS]",
+	"RENAME TO",
+	"ALTER COLUMN [IF EXISTS]",
+	"SET DEFAULT COLLATE",
+	"SET OPTIONS",
+	"DROP NOT NULL",
+	"SET DATA TYPE",
+	"ALTER SCHEMA [IF EXISTS]",
+	"ALTER [MATERIALIZED] VIEW [IF EXISTS]",
+	"TRUNCATE TABLE",
+	"CREATE SCHEMA [IF NOT EXISTS]",
+	"DEFAULT COLLATE",
+	"CREATE [OR REPLACE] [TEMP | TEMPORARY | TABLE] FUNCTION [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] PROCEDURE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] ROW ACCESS POLICY [IF NOT EXISTS]",
+	"GRANT TO",
+	"FILTER USING",
+	"CREATE SEARCH INDEX [IF NOT EXISTS]",
+	"DROP SCHEMA [IF EXISTS]",
+	"DROP [MATERIALIZED] VIEW [IF EXISTS]",
+	"DROP [TABLE] FUNCTION [IF EXISTS]",
+	"DROP PROCEDURE [IF EXISTS]",
+	"DROP ROW ACCESS POLICY",
+	"DROP ALL ROW ACCESS POLICIES",
+	"DROP SEARCH INDEX [IF EXISTS]",
+	"GRANT",
+	"REVOKE",
+	"DECLARE",
+	"EXECUTE IMMEDIATE",
+	"LOOP",
+	"END LOOP",
+	"REPEAT",
+	"END REPEAT",
+	"WHILE",
+	"END WHILE",
+	"BREAK",
+	"LEAVE",
+	"CONTINUE",
+	"ITERATE",
+	"FOR",
+	"END FOR",
+	"BEGIN",
+	"BEGIN TRANSACTION",
+	"COMMIT TRANSACTION",
+	"ROLLBACK TRANSACTION",
+	"RAISE",
+	"RETURN",
+	"CALL",
+	"ASSERT",
+	"EXPORT DATA",
+}
+
+var bigqueryReservedSetOperationsPhrases = []string{
+	"UNION {ALL | DISTINCT}",
+	"EXCEPT DISTINCT",
+	"INTERSECT DISTINCT",
+}
+
+var bigqueryReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+}
+
+var bigqueryReservedKeywordPhrasesPhrases = []string{
+	"TABLESAMPLE SYSTEM",
+	"ANY TYPE",
+	"ALL COLUMNS",
+	"NOT DETERMINISTIC",
+	"{ROWS | RANGE} BETWEEN",
+	"IS [NOT] DISTINCT FROM",
+}
+
+var bigqueryStringPrefixes = []string{"R", "B", "RB", "BR"}
+
+var BigqueryDialect = DialectOptions{
+	Name: "bigquery",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(bigqueryReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(bigqueryReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(bigqueryStandardOnelineClausesPhrases)...), ExpandPhrases(bigqueryTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(bigqueryReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(bigqueryReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(bigqueryReservedKeywordPhrasesPhrases),
+		ReservedKeywords:       bigquery.Keywords,
+		ReservedDataTypes:      bigquery.DataTypes,
+		ReservedFunctionNames:  bigquery.Functions,
+		ExtraParens:            []string{"[]"},
+		StringTypes: []QuoteType{
+			// Triple-quoted strings come first so that """ isn't read as an
+			// empty "" string followed by a stray quote.
+			PrefixedQuoteType{Quote: PlainQuoteType(`""".."""`), Prefixes: bigqueryStringPrefixes},
+			PrefixedQuoteType{Quote: PlainQuoteType("'''..'''"), Prefixes: bigqueryStringPrefixes},
+			PlainQuoteType("\"\"-bs"),
+			PlainQuoteType("''-bs"),
+			PrefixedQuoteType{Quote: PlainQuoteType("\"\"-raw"), Prefixes: bigqueryStringPrefixes, RequirePrefix: true},
+			PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: bigqueryStringPrefixes, RequirePrefix: true},
+		},
+		IdentTypes:       []QuoteType{PlainQuoteType("``")},
+		IdentChars:       &IdentChars{Dashes: true},
+		ParamTypes:       &ParamTypes{Positional: true, Named: []string{"@"}, Quoted: []string{"@"}},
+		VariableTypes:    []VariableType{RegexPattern{Regex: `@@\w+`}},
+		LineCommentTypes: []string{"--", "#"},
+		Operators:        []string{"&", "|", "^", "~", ">>", "<<", "||", "=>"},
+		PostProcess:      bigqueryPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(bigqueryStandardOnelineClausesPhrases)...), ExpandPhrases(bigqueryTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(bigqueryTabularOnelineClausesPhrases),
+	},
+}
+
+func bigqueryPostProcess(tokens []Token) []Token {
+	return detectBigqueryArraySubscripts(combineParameterizedTypes(tokens))
+}
+
+// detectBigqueryArraySubscripts turns OFFSET in arr[OFFSET(1)] into a function
+// call rather than the OFFSET clause, and REPLACE in SELECT * REPLACE (...)
+// into a keyword rather than the REPLACE() string function.
+func detectBigqueryArraySubscripts(tokens []Token) []Token {
+	for i, token := range tokens {
+		prev := prevNonCommentToken(tokens, i)
+		switch {
+		case token.Type == TokenReservedClause && token.Text == "OFFSET" && isOpenBracket(prev):
+			tokens[i].Type = TokenReservedFunctionName
+		case token.Type == TokenReservedFunctionName && token.Text == "REPLACE" && prev.Type == TokenAsterisk:
+			tokens[i].Type = TokenReservedKeyword
+		}
+	}
+	return tokens
+}
+
+// combineParameterizedTypes folds angle-bracket types like STRUCT<a INT64> or
+// ARRAY<STRUCT<x STRING>> into a single data type token, so the parser sees
+// them the same way it sees a plain ARRAY or STRUCT.
+func combineParameterizedTypes(tokens []Token) []Token {
+	processed := make([]Token, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if !(IsTokenArray(token) || IsTokenStruct(token)) || i+1 >= len(tokens) || tokens[i+1].Text != "<" {
+			processed = append(processed, token)
+			continue
+		}
+		end := findClosingAngleBracketIndex(tokens, i+1)
+		var raw, text strings.Builder
+		for j := i; j <= end; j++ {
+			if j > i && needsSpaceInTypeDef(tokens[j-1], tokens[j]) {
+				raw.WriteByte(' ')
+				text.WriteByte(' ')
+			}
+			raw.WriteString(tokens[j].Raw)
+			text.WriteString(tokens[j].Text)
+		}
+		processed = append(processed, Token{
+			Type:                TokenReservedDataType,
+			Raw:                 raw.String(),
+			Text:                text.String(),
+			Start:               token.Start,
+			End:                 tokens[end].End,
+			PrecedingWhitespace: token.PrecedingWhitespace,
+		})
+		i = end
+	}
+	return processed
+}
+
+func needsSpaceInTypeDef(prev Token, next Token) bool {
+	if prev.Type == TokenComma {
+		return true
+	}
+	switch prev.Text {
+	case "<", "(":
+		return false
+	}
+	switch next.Text {
+	case "<", ">", ">>", "(", ")", ",":
+		return false
+	}
+	return true
+}
+
+func findClosingAngleBracketIndex(tokens []Token, start int) int {
+	level := 0
+	for i := start; i < len(tokens); i++ {
+		switch tokens[i].Text {
+		case "<":
+			level++
+		case ">":
+			level--
+		case ">>":
+			level -= 2
+		}
+		if level <= 0 {
+			return i
+		}
+	}
+	return len(tokens) - 1
+}
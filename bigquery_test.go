@@ -0,0 +1,221 @@
+package sqlformatter
+
+import "testing"
+
+func TestBigqueryFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageBigquery, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageBigquery, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{HashComments: true})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, Materialized: true, IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{OrReplace: true, IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, RenameTo: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format, insertIntoConfig{WithoutInto: true})
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true})
+	supportsStrings(t, format, formatErr, []string{"\"\"-bs", "''-bs", `R""`, "R''", `B""`, "B''"})
+	supportsIdentifiers(t, format, formatErr, []string{"``"})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{SupportsUsing: true, Without: []string{"NATURAL"}})
+	supportsSetOperations(t, format, []string{"UNION ALL", "UNION DISTINCT", "EXCEPT DISTINCT", "INTERSECT DISTINCT"})
+	supportsOperators(t, format, []string{"&", "|", "^", "~", ">>", "<<", "||", "=>"}, operatorConfig{Any: true})
+	supportsIsDistinctFrom(t, format)
+	supportsParams(t, format, paramConfig{Positional: true, Named: []string{"@"}, Quoted: []string{"@"}})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("supports dashes inside identifiers", func(t *testing.T) {
+		result := format("SELECT alpha-foo, where-long-identifier\nFROM beta")
+		expected := dedent(`
+			SELECT
+			  alpha-foo,
+			  where-long-identifier
+			FROM
+			  beta
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("does not treat a trailing dash or line comment as part of an identifier", func(t *testing.T) {
+		result := format("SELECT a--comment\nFROM b")
+		expected := dedent(`
+			SELECT
+			  a --comment
+			FROM
+			  b
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports dashed project names in table paths", func(t *testing.T) {
+		result := format("SELECT * FROM my-project.dataset.tbl JOIN `other-project.dataset.tbl2` USING (id)")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  my-project.dataset.tbl
+			  JOIN ` + "`other-project.dataset.tbl2`" + ` USING (id)
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports triple-quoted strings", func(t *testing.T) {
+		result := format(`SELECT '''hello 'my' world''', """hello "my" world""", r'''raw\d''', b"""bytes""";`)
+		expected := dedent(`
+			SELECT
+			  '''hello 'my' world''',
+			  """hello "my" world""",
+			  r'''raw\d''',
+			  b"""bytes""";
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports @@variables", func(t *testing.T) {
+		result := format("SELECT @@error.message, @@project_id;")
+		expected := dedent(`
+			SELECT
+			  @@error.message,
+			  @@project_id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports QUALIFY clause", func(t *testing.T) {
+		result := format("SELECT item, RANK() OVER (PARTITION BY category ORDER BY purchases DESC) AS rank FROM Produce WHERE Produce.category = 'vegetable' QUALIFY rank <= 3")
+		expected := dedent(`
+			SELECT
+			  item,
+			  RANK() OVER (
+			    PARTITION BY
+			      category
+			    ORDER BY
+			      purchases DESC
+			  ) AS rank
+			FROM
+			  Produce
+			WHERE
+			  Produce.category = 'vegetable'
+			QUALIFY
+			  rank <= 3
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports STRUCT and ARRAY constructors", func(t *testing.T) {
+		result := format(`SELECT STRUCT("Alpha" as name, [23.4, 26.3] as splits), ARRAY(SELECT 1) FROM beta`)
+		expected := dedent(`
+			SELECT
+			  STRUCT("Alpha" as name, [23.4, 26.3] as splits),
+			  ARRAY(
+			    SELECT
+			      1
+			  )
+			FROM
+			  beta
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports parametric ARRAY", func(t *testing.T) {
+		result := format("SELECT ARRAY<FLOAT64>[1, 2]")
+		expected := dedent(`
+			SELECT
+			  ARRAY<FLOAT64>[1, 2]
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports nested parametric STRUCT", func(t *testing.T) {
+		result := format("SELECT STRUCT<ARRAY<INT64>>([])")
+		expected := dedent(`
+			SELECT
+			  STRUCT<ARRAY<INT64>>([])
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports parametric STRUCT with named fields", func(t *testing.T) {
+		result := format(`SELECT STRUCT<Y FLOAT64,X STRING(10)>(1, "foo")`)
+		expected := dedent(`
+			SELECT
+			  STRUCT<Y FLOAT64, X STRING(10)>(1, "foo")
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports STRUCT types in CREATE TABLE", func(t *testing.T) {
+		result := format("CREATE TABLE t (a STRUCT<b INT64, c ARRAY<STRING>>, d ARRAY<DATE>);")
+		assertEqual(t, result, "CREATE TABLE t (a STRUCT<b INT64, c ARRAY<STRING>>, d ARRAY<DATE>);")
+	})
+
+	t.Run("changes case of parametric STRUCT keywords", func(t *testing.T) {
+		result := format("select struct<nr int64, name string>(1, 'foo');", FormatOptions{KeywordCase: KeywordCaseUpper, DataTypeCase: KeywordCaseUpper})
+		expected := dedent(`
+			SELECT
+			  STRUCT<nr INT64, name STRING>(1, 'foo');
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports OFFSET and SAFE_OFFSET array subscripts", func(t *testing.T) {
+		result := format("SELECT arr[OFFSET(1)], arr[SAFE_OFFSET(2)] FROM t LIMIT 10 OFFSET 5")
+		expected := dedent(`
+			SELECT
+			  arr[OFFSET(1)],
+			  arr[SAFE_OFFSET(2)]
+			FROM
+			  t
+			LIMIT
+			  10
+			OFFSET
+			  5
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports SELECT * EXCEPT and REPLACE", func(t *testing.T) {
+		result := format("SELECT * EXCEPT (a, b), * REPLACE (quantity/2 AS quantity) FROM orders;")
+		expected := dedent(`
+			SELECT
+			  * EXCEPT (a, b),
+			  * REPLACE (quantity / 2 AS quantity)
+			FROM
+			  orders;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports SELECT AS STRUCT", func(t *testing.T) {
+		result := format("SELECT AS STRUCT 1 a, 2 b")
+		expected := dedent(`
+			SELECT AS STRUCT
+			  1 a,
+			  2 b
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports MERGE statements", func(t *testing.T) {
+		result := format("MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN UPDATE SET x = s.x WHEN NOT MATCHED BY TARGET THEN INSERT ROW")
+		expected := dedent(`
+			MERGE INTO
+			  t USING s ON t.id = s.id
+			WHEN MATCHED THEN
+			UPDATE SET
+			  x = s.x
+			WHEN NOT MATCHED BY TARGET THEN
+			INSERT ROW
+		`)
+		assertEqual(t, result, expected)
+	})
+}
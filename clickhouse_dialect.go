@@ -0,0 +1,119 @@
+package sqlformatter
+
+import clickhouse "sql-formatter-go/languages/clickhouse"
+
+var clickhouseReservedSelectPhrases = []string{
+	"SELECT [DISTINCT]",
+}
+
+var clickhouseReservedClausesPhrases = []string{
+	"WITH",
+	"FROM",
+	"SAMPLE BY",
+	"PREWHERE",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"WINDOW",
+	"QUALIFY",
+	"PARTITION BY",
+	"ORDER BY",
+	"LIMIT",
+	"OFFSET",
+	"SETTINGS",
+	"INSERT INTO [TABLE] [FUNCTION]",
+	"VALUES",
+	"SET",
+}
+
+var clickhouseStandardOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [TEMPORARY] TABLE [IF NOT EXISTS]",
+}
+
+var clickhouseTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] VIEW [IF NOT EXISTS]",
+	"CREATE MATERIALIZED VIEW [IF NOT EXISTS]",
+	"CREATE DATABASE [IF NOT EXISTS]",
+	"CREATE DICTIONARY [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] FUNCTION",
+	"ENGINE",
+	"FORMAT",
+	"UPDATE",
+	"DELETE FROM",
+	"DELETE WHERE",
+	"DROP {TABLE | VIEW | DATABASE | DICTIONARY | FUNCTION} [IF EXISTS]",
+	"ALTER TABLE",
+	"ADD COLUMN [IF NOT EXISTS]",
+	"DROP COLUMN [IF EXISTS]",
+	"MODIFY COLUMN [IF EXISTS]",
+	"RENAME COLUMN [IF EXISTS]",
+	"RENAME TABLE",
+	"RENAME TO",
+	"TRUNCATE [TABLE] [IF EXISTS]",
+	"OPTIMIZE TABLE",
+	"EXCHANGE TABLES",
+	"SYSTEM",
+	"SHOW",
+	"DESCRIBE [TABLE]",
+	"KILL QUERY",
+	"USE",
+}
+
+var clickhouseReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT]",
+	"EXCEPT [ALL | DISTINCT]",
+	"INTERSECT [ALL | DISTINCT]",
+}
+
+var clickhouseReservedJoinsPhrases = []string{
+	"[GLOBAL] [ANY | ALL | ASOF] JOIN",
+	"[GLOBAL] [ANY | ALL | ASOF] {INNER | LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"[GLOBAL] {LEFT | RIGHT} {SEMI | ANTI} JOIN",
+	"[GLOBAL] CROSS JOIN",
+	"PASTE JOIN",
+	"[LEFT] ARRAY JOIN",
+}
+
+var clickhouseReservedKeywordPhrasesPhrases = []string{
+	"WITH {TOTALS | ROLLUP | CUBE | FILL | TIES}",
+	"ON CLUSTER",
+	"{ROWS | RANGE} BETWEEN",
+	"NULLS {FIRST | LAST}",
+}
+
+var ClickhouseDialect = DialectOptions{
+	Name: "clickhouse",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(clickhouseReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(clickhouseReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(clickhouseStandardOnelineClausesPhrases)...), ExpandPhrases(clickhouseTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(clickhouseReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(clickhouseReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(clickhouseReservedKeywordPhrasesPhrases),
+		ReservedKeywords:       clickhouse.Keywords,
+		ReservedDataTypes:      clickhouse.DataTypes,
+		ReservedFunctionNames:  clickhouse.Functions,
+		ExtraParens:            []string{"[]", "{}"},
+		UnderscoresInNumbers:   true,
+		StringTypes:            []QuoteType{PlainQuoteType("''-qq-bs")},
+		IdentTypes:             []QuoteType{PlainQuoteType("\"\"-qq"), PlainQuoteType("``")},
+		ParamTypes:             &ParamTypes{Typed: true},
+		LineCommentTypes:       []string{"--", "#"},
+		Operators:              []string{"%", "||", "==", "->", "?", ":"},
+		PostProcess:            clickhousePostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(clickhouseStandardOnelineClausesPhrases)...), ExpandPhrases(clickhouseTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(clickhouseTabularOnelineClausesPhrases),
+	},
+}
+
+// clickhousePostProcess turns FORMAT(...) back into the format() string
+// function; only the FORMAT trailer naming an output format is a clause.
+func clickhousePostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type == TokenReservedClause && token.Text == "FORMAT" && isOpenParen(nextNonCommentToken(tokens, i)) {
+			tokens[i].Type = TokenReservedFunctionName
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,136 @@
+package sqlformatter
+
+import "testing"
+
+func TestClickhouseFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageClickhouse, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageClickhouse, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{HashComments: true})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, Materialized: true, IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true, WithoutTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "''-bs"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq", "``"})
+	supportsArrayLiterals(t, format, arrayLiteralConfig{WithoutArrayPrefix: true})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{Without: []string{"NATURAL"}, Additionally: []string{"ANY LEFT JOIN", "GLOBAL INNER JOIN", "ASOF JOIN", "LEFT SEMI JOIN", "PASTE JOIN"}})
+	supportsSetOperations(t, format, []string{"UNION ALL", "UNION DISTINCT", "EXCEPT", "INTERSECT"})
+	supportsOperators(t, format, []string{"%", "||", "=="}, operatorConfig{Any: true})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("formats table engine and settings", func(t *testing.T) {
+		result := format("CREATE TABLE IF NOT EXISTS events (id UInt64, ts DateTime) ENGINE = MergeTree() PARTITION BY toYYYYMM(ts) ORDER BY (id, ts) SETTINGS index_granularity = 8192;")
+		expected := dedent(`
+			CREATE TABLE IF NOT EXISTS events (id UInt64, ts DateTime)
+			ENGINE = MergeTree()
+			PARTITION BY
+			  toYYYYMM(ts)
+			ORDER BY
+			  (id, ts)
+			SETTINGS
+			  index_granularity = 8192;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats FINAL, SAMPLE, ARRAY JOIN and PREWHERE", func(t *testing.T) {
+		result := format("SELECT id, tag FROM events FINAL SAMPLE 0.1 LEFT ARRAY JOIN tags AS tag PREWHERE ts > now() WHERE id > 10")
+		expected := dedent(`
+			SELECT
+			  id,
+			  tag
+			FROM
+			  events FINAL SAMPLE 0.1
+			  LEFT ARRAY JOIN tags AS tag
+			PREWHERE
+			  ts > now()
+			WHERE
+			  id > 10
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats SETTINGS and FORMAT trailers", func(t *testing.T) {
+		result := format("SELECT * FROM t LIMIT 10 SETTINGS max_threads = 8, max_memory_usage = 100 FORMAT JSONEachRow")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  t
+			LIMIT
+			  10
+			SETTINGS
+			  max_threads = 8,
+			  max_memory_usage = 100
+			FORMAT JSONEachRow
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps format() as a function", func(t *testing.T) {
+		result := format("SELECT format('{} {}', a, b) FROM t")
+		expected := dedent(`
+			SELECT
+			  format('{} {}', a, b)
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports {name:Type} parameters", func(t *testing.T) {
+		result := format("SELECT * FROM t WHERE ts > {since: DateTime} AND id IN {ids:Array(UInt64)}")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  t
+			WHERE
+			  ts > {since: DateTime}
+			  AND id IN {ids:Array(UInt64)}
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("replaces {name:Type} parameters", func(t *testing.T) {
+		result := format("SELECT * FROM t WHERE ts > {since: DateTime} AND id IN {ids:Array(UInt64)}", FormatOptions{
+			Params: ParamItems{"since": "'2024-01-01'", "ids": "[1, 2]"},
+		})
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  t
+			WHERE
+			  ts > '2024-01-01'
+			  AND id IN [1, 2]
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports lambdas and WITH TOTALS", func(t *testing.T) {
+		result := format("SELECT arrayMap(x -> x * 2, [1, 2]) FROM t GROUP BY a WITH TOTALS")
+		expected := dedent(`
+			SELECT
+			  arrayMap(x -> x * 2, [1, 2])
+			FROM
+			  t
+			GROUP BY
+			  a WITH TOTALS
+		`)
+		assertEqual(t, result, expected)
+	})
+}
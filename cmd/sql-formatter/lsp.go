@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"sql-formatter-go/lsp"
+)
+
+// runLSP serves the Language Server Protocol over stdin and stdout.
+func runLSP(args []string) {
+	fs := flag.NewFlagSet(os.Args[0]+" lsp", flag.ExitOnError)
+	lang := fs.String("language", "sql", "SQL dialect (defaults to basic sql)")
+	langShort := fs.String("l", "", "SQL dialect (defaults to basic sql)")
+	config := fs.String("config", "", "Path to config JSON file or json string (will find a file named '.sql-formatter.json' or use default configs if unspecified)")
+	configShort := fs.String("c", "", "Path to config JSON file or json string (will find a file named '.sql-formatter.json' or use default configs if unspecified)")
+	dialectFile := fs.String("dialect-file", "", "Path to a JSON or YAML dialect definition (overrides --language)")
+	if err := fs.Parse(args); err != nil {
+		os.Exit(2)
+	}
+	if *config == "" && *configShort != "" {
+		*config = *configShort
+	}
+	if *lang == "sql" && *langShort != "" {
+		*lang = *langShort
+	}
+
+	formatter, err := newFormatter(*lang, *config, *dialectFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	server := lsp.NewServer(formatter)
+	server.Version = version
+	if err := server.Serve(context.Background(), os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/scanner"
 	"io"
 	"os"
 	"path/filepath"
@@ -15,26 +16,36 @@ import (
 	"sync/atomic"
 
 	sqlformatter "sql-formatter-go"
+	"sql-formatter-go/goembed"
 )
 
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "lsp" {
+		runLSP(os.Args[2:])
+		return
+	}
+
 	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 	output := fs.String("output", "", "File to write SQL output (defaults to stdout)")
 	outputShort := fs.String("o", "", "File to write SQL output (defaults to stdout)")
 	fix := fs.Bool("fix", false, "Update the file in-place")
 	check := fs.Bool("check", false, "Check if files are formatted (exit 1 if not)")
+	diff := fs.Bool("diff", false, "Print a unified diff of the changes instead of the formatted SQL (exit 1 if there are any)")
 	lang := fs.String("language", "sql", "SQL dialect (defaults to basic sql)")
 	langShort := fs.String("l", "", "SQL dialect (defaults to basic sql)")
 	config := fs.String("config", "", "Path to config JSON file or json string (will find a file named '.sql-formatter.json' or use default configs if unspecified)")
 	configShort := fs.String("c", "", "Path to config JSON file or json string (will find a file named '.sql-formatter.json' or use default configs if unspecified)")
+	goSource := fs.Bool("go", false, "Format the SQL embedded in Go files instead of SQL files")
+	dialectFile := fs.String("dialect-file", "", "Path to a JSON or YAML dialect definition (overrides --language)")
 	showVersion := fs.Bool("version", false, "show program's version number and exit")
 	workers := fs.Int("workers", 0, "number of concurrent workers for multiple files (0 = NumCPU)")
 	cpuProfile := fs.String("cpuprofile", "", "write CPU profile to file")
 	allocProfile := fs.String("allocprofile", "", "write allocation profile to file")
 	fs.Usage = func() {
-		fmt.Fprintf(fs.Output(), "usage: %s [-h] [-o OUTPUT] [-l {postgresql,sql}] [-c CONFIG] [--version] [FILE...]\n\n", os.Args[0])
+		fmt.Fprintf(fs.Output(), "usage: %s [-h] [-o OUTPUT] [-l {bigquery,clickhouse,duckdb,mariadb,mysql,plsql,postgresql,redshift,snowflake,spark,sql,sqlite,transactsql,trino,tsql}] [-c CONFIG] [--dialect-file FILE] [--go] [--version] [FILE...]\n", os.Args[0])
+		fmt.Fprintf(fs.Output(), "       %s lsp [-l LANGUAGE] [-c CONFIG] [--dialect-file FILE]\n\n", os.Args[0])
 		fmt.Fprintln(fs.Output(), "SQL Formatter")
 		fmt.Fprintln(fs.Output())
 		fmt.Fprintln(fs.Output(), "positional arguments:")
@@ -46,10 +57,14 @@ func main() {
 		fmt.Fprintln(fs.Output(), "                    File to write SQL output (defaults to stdout)")
 		fmt.Fprintln(fs.Output(), "  --fix           Update the file in-place")
 		fmt.Fprintln(fs.Output(), "  --check         Check if files are formatted (exit 1 if not)")
-		fmt.Fprintln(fs.Output(), "  -l, --language  {postgresql,sql}")
+		fmt.Fprintln(fs.Output(), "  --diff          Print a unified diff of the changes instead of the formatted SQL (exit 1 if there are any)")
+		fmt.Fprintln(fs.Output(), "  -l, --language  {bigquery,clickhouse,duckdb,mariadb,mysql,plsql,postgresql,redshift,snowflake,spark,sql,sqlite,transactsql,trino,tsql}")
 		fmt.Fprintln(fs.Output(), "                    SQL dialect (defaults to basic sql)")
 		fmt.Fprintln(fs.Output(), "  -c, --config    CONFIG")
 		fmt.Fprintln(fs.Output(), "                    Path to config JSON file or json string (will find a file named '.sql-formatter.json' or use default configs if unspecified)")
+		fmt.Fprintln(fs.Output(), "  --dialect-file  FILE")
+		fmt.Fprintln(fs.Output(), "                    Path to a JSON or YAML dialect definition (overrides --language)")
+		fmt.Fprintln(fs.Output(), "  --go            Format the SQL embedded in Go files instead of SQL files")
 		fmt.Fprintln(fs.Output(), "  --workers       number of concurrent workers for multiple files (0 = NumCPU)")
 		fmt.Fprintln(fs.Output(), "  --cpuprofile    write CPU profile to file")
 		fmt.Fprintln(fs.Output(), "  --allocprofile  write allocation profile to file")
@@ -81,7 +96,7 @@ func main() {
 	}
 
 	var err error
-	files, err = expandInputFiles(files)
+	files, err = expandInputFiles(files, *goSource)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
@@ -99,6 +114,14 @@ func main() {
 		fmt.Fprintln(os.Stderr, "Error: Cannot use both --check and --output options simultaneously")
 		os.Exit(1)
 	}
+	if *diff && *fix {
+		fmt.Fprintln(os.Stderr, "Error: Cannot use both --diff and --fix options simultaneously")
+		os.Exit(1)
+	}
+	if *diff && *output != "" {
+		fmt.Fprintln(os.Stderr, "Error: Cannot use both --diff and --output options simultaneously")
+		os.Exit(1)
+	}
 	if *fix && len(files) == 0 {
 		fmt.Fprintln(os.Stderr, "Error: The --fix option cannot be used without a filename")
 		os.Exit(1)
@@ -117,16 +140,17 @@ func main() {
 		return
 	}
 
-	cfgMap, err := loadConfig(*config)
+	formatter, err := newFormatter(*lang, *config, *dialectFile)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
-
-	cfg, err := buildConfig(*lang, cfgMap)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err.Error())
-		os.Exit(1)
+	format := formatter.Format
+	if *goSource {
+		format = func(src string) (string, error) {
+			formatted, err := goembed.Format([]byte(src), formatter)
+			return string(formatted), err
+		}
 	}
 
 	if len(files) == 0 {
@@ -135,12 +159,19 @@ func main() {
 			fmt.Fprintln(os.Stderr, err.Error())
 			os.Exit(1)
 		}
-		formatted, err := sqlformatter.Format(query, cfg)
+		formatted, err := format(query)
 		if err != nil {
-			fmt.Fprintln(os.Stderr, err.Error())
+			fmt.Fprintln(os.Stderr, errorMessage("<stdin>", err))
 			os.Exit(1)
 		}
 		formatted = strings.TrimSpace(formatted) + "\n"
+		if *diff {
+			if query != formatted {
+				writeDiff(sqlformatter.UnifiedDiff("<stdin>.orig", "<stdin>", query, formatted))
+				os.Exit(1)
+			}
+			return
+		}
 		if *output == "" {
 			_, _ = os.Stdout.WriteString(formatted)
 			return
@@ -152,7 +183,7 @@ func main() {
 		return
 	}
 
-	runMultiFile(files, cfg, *fix, *check, *output, *workers, cleanupProfile)
+	runMultiFile(files, format, *fix, *check, *diff, *output, *workers, cleanupProfile)
 }
 
 func startProfiling(cpuPath, allocPath string) func() {
@@ -206,7 +237,15 @@ func startProfiling(cpuPath, allocPath string) func() {
 	}
 }
 
-func expandInputFiles(files []string) ([]string, error) {
+// expandInputFiles replaces the directories in files with the SQL files in
+// them, or with the Go files when goSource is set. Like the go command, Go
+// files are not looked for in vendor, testdata, or directories whose name
+// starts with . or _.
+func expandInputFiles(files []string, goSource bool) ([]string, error) {
+	ext := ".sql"
+	if goSource {
+		ext = ".go"
+	}
 	expanded := make([]string, 0, len(files))
 	for _, path := range files {
 		info, err := os.Stat(path)
@@ -222,9 +261,12 @@ func expandInputFiles(files []string) ([]string, error) {
 				return walkErr
 			}
 			if entry.IsDir() {
+				if goSource && child != path && isIgnoredGoDir(entry.Name()) {
+					return filepath.SkipDir
+				}
 				return nil
 			}
-			if strings.EqualFold(filepath.Ext(child), ".sql") {
+			if strings.EqualFold(filepath.Ext(child), ext) {
 				expanded = append(expanded, child)
 			}
 			return nil
@@ -236,6 +278,45 @@ func expandInputFiles(files []string) ([]string, error) {
 	return expanded, nil
 }
 
+func isIgnoredGoDir(name string) bool {
+	return name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// writeDiff prints a unified diff to stdout, in color when stdout is a
+// terminal and NO_COLOR is not set.
+func writeDiff(diff string) {
+	if diff == "" {
+		return
+	}
+	if !isTTY(os.Stdout) || os.Getenv("NO_COLOR") != "" {
+		_, _ = os.Stdout.WriteString(diff)
+		return
+	}
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		color := ""
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			color = "\x1b[1m"
+		case strings.HasPrefix(line, "@@"):
+			color = "\x1b[36m"
+		case strings.HasPrefix(line, "-"):
+			color = "\x1b[31m"
+		case strings.HasPrefix(line, "+"):
+			color = "\x1b[32m"
+		}
+		if color == "" {
+			out.WriteString(line)
+			continue
+		}
+		out.WriteString(color + strings.TrimSuffix(line, "\n") + "\x1b[0m")
+		if strings.HasSuffix(line, "\n") {
+			out.WriteString("\n")
+		}
+	}
+	_, _ = os.Stdout.WriteString(out.String())
+}
+
 func isTTY(f *os.File) bool {
 	info, err := f.Stat()
 	if err != nil {
@@ -244,6 +325,22 @@ func isTTY(f *os.File) bool {
 	return (info.Mode() & os.ModeCharDevice) != 0
 }
 
+// errorMessage formats err for stderr. Parse errors are reported as
+// file:line:col: message followed by the offending line, so that editors and
+// CI annotations can jump to them.
+func errorMessage(file string, err error) string {
+	var syntaxErr scanner.ErrorList
+	if errors.As(err, &syntaxErr) {
+		// Go syntax errors are located without the file name
+		return file + ":" + err.Error()
+	}
+	var parseErr sqlformatter.ParseError
+	if !errors.As(err, &parseErr) {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s:%d:%d: %s\n%s", file, parseErr.Line, parseErr.Column, parseErr.Message(), parseErr.Snippet)
+}
+
 func readInput(file string) (string, error) {
 	if file == "" {
 		data, err := io.ReadAll(os.Stdin)
@@ -262,7 +359,7 @@ func readInput(file string) (string, error) {
 	return string(data), nil
 }
 
-func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fix bool, check bool, output string, workers int, cleanupProfile func()) {
+func runMultiFile(files []string, format func(string) (string, error), fix bool, check bool, diff bool, output string, workers int, cleanupProfile func()) {
 	workerCount := workers
 	if workerCount <= 0 {
 		workerCount = runtime.NumCPU()
@@ -275,7 +372,7 @@ func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fi
 		text      string
 		err       error
 		file      string
-		different bool // for check mode: true if file differs from formatted
+		different bool // for check and diff modes: true if file differs from formatted
 	}
 
 	jobs := make(chan int, len(files))
@@ -295,15 +392,18 @@ func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fi
 					continue
 				}
 				query := string(data)
-				formatted, err := sqlformatter.Format(query, cfg)
+				formatted, err := format(query)
 				if err != nil {
 					results <- result{index: idx, err: err, file: path}
 					continue
 				}
 				formatted = strings.TrimSpace(formatted) + "\n"
-				if check {
-					different := string(data) != formatted
-					results <- result{index: idx, file: path, different: different}
+				if check || diff {
+					res := result{index: idx, file: path, different: query != formatted}
+					if diff && res.different {
+						res.text = sqlformatter.UnifiedDiff(path+".orig", path, query, formatted)
+					}
+					results <- res
 					continue
 				}
 				if fix {
@@ -336,12 +436,14 @@ func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fi
 	for res := range results {
 		if res.err != nil {
 			atomic.AddInt64(&failed, 1)
-			fmt.Fprintln(os.Stderr, res.err.Error())
+			fmt.Fprintln(os.Stderr, errorMessage(res.file, res.err))
 			continue
 		}
-		if check && res.different {
+		if res.different {
 			atomic.AddInt64(&checkFailed, 1)
-			fmt.Fprintf(os.Stderr, "%s\n", res.file)
+			if check {
+				fmt.Fprintf(os.Stderr, "%s\n", res.file)
+			}
 		}
 		out[res.index] = res.text
 	}
@@ -351,7 +453,12 @@ func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fi
 		os.Exit(1)
 	}
 
-	if check {
+	if diff {
+		for _, text := range out {
+			writeDiff(text)
+		}
+	}
+	if check || diff {
 		if checkFailed > 0 {
 			cleanupProfile()
 			os.Exit(1)
@@ -379,6 +486,27 @@ func runMultiFile(files []string, cfg sqlformatter.FormatOptionsWithLanguage, fi
 	}
 }
 
+// newFormatter builds the formatter for the --language, --config and
+// --dialect-file options.
+func newFormatter(lang, config, dialectFile string) (*sqlformatter.Formatter, error) {
+	cfgMap, err := loadConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := buildConfig(lang, cfgMap)
+	if err != nil {
+		return nil, err
+	}
+	if dialectFile != "" {
+		dialect, err := sqlformatter.LoadDialectFile(dialectFile)
+		if err != nil {
+			return nil, err
+		}
+		return sqlformatter.NewWithDialect(sqlformatter.NewDialect(dialect), cfg.FormatOptions)
+	}
+	return sqlformatter.New(cfg)
+}
+
 func loadConfig(configArg string) (map[string]interface{}, error) {
 	if configArg != "" {
 		var cfg map[string]interface{}
@@ -433,8 +561,7 @@ func buildConfig(cliLang string, cfg map[string]interface{}) (sqlformatter.Forma
 		lang = cfgLang
 	}
 	switch strings.ToLower(lang) {
-	case "postgresql", "sql":
-		// both map to postgres for now
+	case "postgresql", "sql", "mysql", "mariadb", "sqlite", "bigquery", "snowflake", "transactsql", "tsql", "plsql", "duckdb", "clickhouse", "spark", "redshift", "trino":
 	default:
 		return sqlformatter.FormatOptionsWithLanguage{}, fmt.Errorf("Unsupported SQL dialect: %s", lang)
 	}
@@ -549,6 +676,9 @@ func parseParamTypes(raw map[string]interface{}) *sqlformatter.ParamTypes {
 	if v, ok := raw["quoted"].([]interface{}); ok {
 		pt.Quoted = toStringSlice(v)
 	}
+	if v, ok := raw["typed"].(bool); ok && v {
+		pt.Typed = true
+	}
 	if v, ok := raw["custom"].([]interface{}); ok {
 		custom := make([]sqlformatter.CustomParameter, 0, len(v))
 		for _, item := range v {
@@ -566,7 +696,7 @@ func parseParamTypes(raw map[string]interface{}) *sqlformatter.ParamTypes {
 			pt.Custom = custom
 		}
 	}
-	if !pt.Positional && len(pt.Numbered) == 0 && len(pt.Named) == 0 && len(pt.Quoted) == 0 && len(pt.Custom) == 0 {
+	if !pt.Positional && !pt.Typed && len(pt.Numbered) == 0 && len(pt.Named) == 0 && len(pt.Quoted) == 0 && len(pt.Custom) == 0 {
 		return nil
 	}
 	return pt
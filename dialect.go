@@ -1,10 +1,5 @@
 package sqlformatter
 
-import (
-	"fmt"
-	"sync"
-)
-
 type DialectFormatOptions struct {
 	AlwaysDenseOperators  []string
 	OnelineClauses        []string
@@ -28,26 +23,26 @@ type Dialect struct {
 	FormatOptions ProcessedDialectFormatOptions
 }
 
-var dialectCache sync.Map
-
-func dialectCacheKey(options DialectOptions) string {
-	if options.Name != "" {
-		return options.Name
+// NewDialect compiles the tokenizer rules and format options described by
+// options. The returned Dialect is immutable and safe for concurrent use, so
+// callers formatting many queries with a custom dialect can build it once and
+// pass it to FormatWithDialect instead of going through the dialect cache.
+func NewDialect(options DialectOptions) *Dialect {
+	return &Dialect{
+		Tokenizer:     NewTokenizer(options.TokenizerOptions, options.Name),
+		FormatOptions: processDialectFormatOptions(options.FormatOptions),
 	}
-	// Fallback to pointer identity for unnamed/custom dialects.
-	return fmt.Sprintf("custom:%p", &options)
 }
 
+// CreateDialect returns a Dialect for options from a bounded cache keyed by the
+// content of options, so equal options share one compiled Dialect.
 func CreateDialect(options DialectOptions) *Dialect {
 	key := dialectCacheKey(options)
-	if cached, ok := dialectCache.Load(key); ok {
-		return cached.(*Dialect)
-	}
-	dialect := &Dialect{
-		Tokenizer:     NewTokenizer(options.TokenizerOptions, options.Name),
-		FormatOptions: processDialectFormatOptions(options.FormatOptions),
+	if cached, ok := dialectCache.get(key); ok {
+		return cached
 	}
-	dialectCache.Store(key, dialect)
+	dialect := NewDialect(options)
+	dialectCache.add(key, dialect)
 	return dialect
 }
 
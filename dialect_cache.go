@@ -0,0 +1,131 @@
+package sqlformatter
+
+import (
+	"container/list"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// dialectCacheSize bounds the number of compiled dialects kept by
+// CreateDialect. Built-in languages don't count against it.
+const dialectCacheSize = 64
+
+var dialectCache = newDialectLRU(dialectCacheSize)
+
+type dialectLRU struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type dialectLRUEntry struct {
+	key     string
+	dialect *Dialect
+}
+
+func newDialectLRU(size int) *dialectLRU {
+	return &dialectLRU{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *dialectLRU) get(key string) (*Dialect, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*dialectLRUEntry).dialect, true
+}
+
+func (c *dialectLRU) add(key string, dialect *Dialect) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&dialectLRUEntry{key: key, dialect: dialect})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*dialectLRUEntry).key)
+	}
+}
+
+func (c *dialectLRU) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// dialectCacheKey hashes every field of options. Functions (PostProcess and
+// custom parameter keys) can only be compared by code pointer, so closures
+// created from the same function literal are indistinguishable; dialects
+// built that way should differ in Name or be compiled with NewDialect.
+func dialectCacheKey(options DialectOptions) string {
+	h := fnv.New128a()
+	tok := options.TokenizerOptions
+	fmt.Fprintf(h, "%q;", options.Name)
+	for _, items := range [][]string{
+		tok.ReservedSelect, tok.ReservedClauses, tok.ReservedSetOperations, tok.ReservedJoins,
+		tok.ReservedKeywordPhrases, tok.ReservedDataTypePhrases, tok.ReservedFunctionNames,
+		tok.ReservedDataTypes, tok.ReservedKeywords, tok.ExtraParens, tok.LineCommentTypes,
+		tok.Operators, tok.PropertyAccessOperators,
+		options.FormatOptions.AlwaysDenseOperators, options.FormatOptions.OnelineClauses,
+		options.FormatOptions.TabularOnelineClauses,
+	} {
+		hashStrings(h, items)
+	}
+	fmt.Fprintf(h, "%t %t %t %t;", tok.SupportsXor, tok.NestedBlockComments, tok.OperatorKeyword, tok.UnderscoresInNumbers)
+	for _, quotes := range [][]QuoteType{tok.StringTypes, tok.IdentTypes} {
+		fmt.Fprintf(h, "%d:", len(quotes))
+		for _, quote := range quotes {
+			fmt.Fprintf(h, "%#v;", quote)
+		}
+	}
+	fmt.Fprintf(h, "%d:", len(tok.VariableTypes))
+	for _, variable := range tok.VariableTypes {
+		fmt.Fprintf(h, "%#v;", variable)
+	}
+	if params := tok.ParamTypes; params != nil {
+		fmt.Fprintf(h, "params %t %t;", params.Positional, params.Typed)
+		hashStrings(h, params.Numbered)
+		hashStrings(h, params.Named)
+		hashStrings(h, params.Quoted)
+		for _, custom := range params.Custom {
+			fmt.Fprintf(h, "%q ", custom.Regex)
+			hashFunc(h, custom.Key)
+		}
+	}
+	for _, chars := range []*IdentChars{tok.IdentChars, tok.ParamChars} {
+		if chars != nil {
+			fmt.Fprintf(h, "%#v;", *chars)
+		} else {
+			io.WriteString(h, "nil;")
+		}
+	}
+	hashFunc(h, tok.PostProcess)
+	return string(h.Sum(nil))
+}
+
+func hashStrings(h hash.Hash, items []string) {
+	fmt.Fprintf(h, "%d:", len(items))
+	for _, item := range items {
+		fmt.Fprintf(h, "%d:%s", len(item), item)
+	}
+}
+
+func hashFunc(h hash.Hash, fn interface{}) {
+	value := reflect.ValueOf(fn)
+	if value.IsNil() {
+		io.WriteString(h, "nil;")
+		return
+	}
+	fmt.Fprintf(h, "%x;", value.Pointer())
+}
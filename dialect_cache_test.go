@@ -0,0 +1,53 @@
+package sqlformatter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDialectCache(t *testing.T) {
+	t.Run("reuses the compiled dialect for unnamed options", func(t *testing.T) {
+		options := PostgresqlDialect
+		options.Name = ""
+		before := CreateDialect(options)
+		for i := 0; i < 10; i++ {
+			if _, err := FormatDialect("SELECT 1", FormatOptionsWithDialect{Dialect: options}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if CreateDialect(options) != before {
+			t.Fatal("expected equal options to share a cached dialect")
+		}
+	})
+
+	t.Run("keys the cache by content rather than name", func(t *testing.T) {
+		renamed := ExtendDialect(MysqlDialect, DialectOverrides{Name: "postgresql"})
+		if CreateDialect(renamed) == CreateDialect(PostgresqlDialect) {
+			t.Fatal("expected dialects with the same name but different options not to share a cache entry")
+		}
+	})
+
+	t.Run("is bounded", func(t *testing.T) {
+		for i := 0; i < dialectCacheSize*2; i++ {
+			CreateDialect(ExtendDialect(SqliteDialect, DialectOverrides{AddFunctions: []string{fmt.Sprintf("FN_%d", i)}}))
+		}
+		if n := dialectCache.len(); n > dialectCacheSize {
+			t.Fatalf("expected at most %d cached dialects, got %d", dialectCacheSize, n)
+		}
+	})
+
+	t.Run("formats with a caller-owned dialect", func(t *testing.T) {
+		dialect := NewDialect(ExtendDialect(PostgresqlDialect, DialectOverrides{AddFunctions: []string{"TIME_BUCKET"}}))
+		result, err := FormatWithDialect("select time_bucket('1 day', ts) from t", dialect, FormatOptions{KeywordCase: KeywordCaseUpper})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			SELECT
+			  time_bucket('1 day', ts)
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+}
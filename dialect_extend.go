@@ -0,0 +1,147 @@
+package sqlformatter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strings"
+)
+
+// DialectOverrides describes the changes ExtendDialect applies to a base
+// dialect. Clause, join, set operation and keyword phrase entries may use the
+// [optional] and {alternative | syntax} understood by ExpandPhrases; keyword,
+// data type, function and operator entries are plain words. Removals are
+// applied to the base dialect before the additions and match case-insensitively.
+type DialectOverrides struct {
+	// Name of the resulting dialect. When empty, a name is derived from the
+	// base dialect name and the overrides, so equal extensions share a cache entry.
+	Name string
+
+	AddReservedClauses    []string
+	RemoveReservedClauses []string
+	// Oneline clauses are also added to the reserved clauses. Removing a
+	// reserved clause removes it from the oneline clauses as well.
+	AddOnelineClauses        []string
+	AddTabularOnelineClauses []string
+
+	AddReservedJoins            []string
+	RemoveReservedJoins         []string
+	AddReservedSetOperations    []string
+	RemoveReservedSetOperations []string
+	AddKeywordPhrases           []string
+	RemoveKeywordPhrases        []string
+
+	AddKeywords     []string
+	RemoveKeywords  []string
+	AddDataTypes    []string
+	RemoveDataTypes []string
+	AddFunctions    []string
+	RemoveFunctions []string
+	AddOperators    []string
+	RemoveOperators []string
+
+	// PostProcess runs after the base dialect's PostProcess, if any.
+	PostProcess func([]Token) []Token
+}
+
+// ExtendDialect returns a copy of base with the overrides applied. The base
+// dialect's slices are never modified.
+func ExtendDialect(base DialectOptions, overrides DialectOverrides) DialectOptions {
+	dialect := base
+	dialect.Name = overrides.Name
+	if dialect.Name == "" {
+		dialect.Name = extendedDialectName(base.Name, overrides)
+	}
+
+	removedClauses := ExpandPhrases(overrides.RemoveReservedClauses)
+	oneline := ExpandPhrases(overrides.AddOnelineClauses)
+	tabular := ExpandPhrases(overrides.AddTabularOnelineClauses)
+
+	tok := &dialect.TokenizerOptions
+	tok.ReservedClauses = extendList(base.TokenizerOptions.ReservedClauses, removedClauses,
+		append(append(ExpandPhrases(overrides.AddReservedClauses), oneline...), tabular...))
+	tok.ReservedJoins = extendList(base.TokenizerOptions.ReservedJoins,
+		ExpandPhrases(overrides.RemoveReservedJoins), ExpandPhrases(overrides.AddReservedJoins))
+	tok.ReservedSetOperations = extendList(base.TokenizerOptions.ReservedSetOperations,
+		ExpandPhrases(overrides.RemoveReservedSetOperations), ExpandPhrases(overrides.AddReservedSetOperations))
+	tok.ReservedKeywordPhrases = extendList(base.TokenizerOptions.ReservedKeywordPhrases,
+		ExpandPhrases(overrides.RemoveKeywordPhrases), ExpandPhrases(overrides.AddKeywordPhrases))
+	tok.ReservedKeywords = extendList(base.TokenizerOptions.ReservedKeywords, overrides.RemoveKeywords, overrides.AddKeywords)
+	tok.ReservedDataTypes = extendList(base.TokenizerOptions.ReservedDataTypes, overrides.RemoveDataTypes, overrides.AddDataTypes)
+	tok.ReservedFunctionNames = extendList(base.TokenizerOptions.ReservedFunctionNames, overrides.RemoveFunctions, overrides.AddFunctions)
+	tok.Operators = extendList(base.TokenizerOptions.Operators, overrides.RemoveOperators, overrides.AddOperators)
+	if overrides.PostProcess != nil {
+		if basePostProcess := base.TokenizerOptions.PostProcess; basePostProcess != nil {
+			tok.PostProcess = func(tokens []Token) []Token {
+				return overrides.PostProcess(basePostProcess(tokens))
+			}
+		} else {
+			tok.PostProcess = overrides.PostProcess
+		}
+	}
+
+	format := &dialect.FormatOptions
+	format.OnelineClauses = extendList(base.FormatOptions.OnelineClauses, removedClauses, append(append([]string{}, oneline...), tabular...))
+	// an empty list makes all oneline clauses tabular, which must hold for the
+	// base clauses once the list is extended
+	baseTabular := base.FormatOptions.TabularOnelineClauses
+	if len(baseTabular) == 0 {
+		baseTabular = base.FormatOptions.OnelineClauses
+	}
+	format.TabularOnelineClauses = extendList(baseTabular, removedClauses, tabular)
+	return dialect
+}
+
+// extendList returns a new list with the removed entries dropped from base and
+// the added entries that are not already present appended.
+func extendList(base []string, remove []string, add []string) []string {
+	if len(remove) == 0 && len(add) == 0 {
+		return base
+	}
+	removed := make(map[string]bool, len(remove))
+	for _, item := range remove {
+		removed[strings.ToUpper(item)] = true
+	}
+	seen := make(map[string]bool, len(base)+len(add))
+	out := make([]string, 0, len(base)+len(add))
+	for _, item := range base {
+		if removed[strings.ToUpper(item)] {
+			continue
+		}
+		seen[strings.ToUpper(item)] = true
+		out = append(out, item)
+	}
+	for _, item := range add {
+		key := strings.ToUpper(item)
+		if removed[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, item)
+	}
+	return out
+}
+
+func extendedDialectName(base string, overrides DialectOverrides) string {
+	h := fnv.New64a()
+	for _, list := range [][]string{
+		overrides.AddReservedClauses, overrides.RemoveReservedClauses,
+		overrides.AddOnelineClauses, overrides.AddTabularOnelineClauses,
+		overrides.AddReservedJoins, overrides.RemoveReservedJoins,
+		overrides.AddReservedSetOperations, overrides.RemoveReservedSetOperations,
+		overrides.AddKeywordPhrases, overrides.RemoveKeywordPhrases,
+		overrides.AddKeywords, overrides.RemoveKeywords,
+		overrides.AddDataTypes, overrides.RemoveDataTypes,
+		overrides.AddFunctions, overrides.RemoveFunctions,
+		overrides.AddOperators, overrides.RemoveOperators,
+	} {
+		fmt.Fprintf(h, "%q;", list)
+	}
+	if overrides.PostProcess != nil {
+		fmt.Fprintf(h, "%x", reflect.ValueOf(overrides.PostProcess).Pointer())
+	}
+	if base == "" {
+		base = "custom"
+	}
+	return fmt.Sprintf("%s+%016x", base, h.Sum64())
+}
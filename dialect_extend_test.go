@@ -0,0 +1,110 @@
+package sqlformatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtendDialect(t *testing.T) {
+	timescale := ExtendDialect(PostgresqlDialect, DialectOverrides{
+		Name:           "timescaledb",
+		AddFunctions:   []string{"TIME_BUCKET", "FIRST", "LAST"},
+		RemoveKeywords: []string{"window"},
+		AddOperators:   []string{"<~>"},
+	})
+	format := func(query string) string {
+		t.Helper()
+		result, err := FormatDialect(query, FormatOptionsWithDialect{Dialect: timescale})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("adds functions and operators", func(t *testing.T) {
+		result := format("SELECT time_bucket('5 minutes', ts), a <~> b FROM metrics")
+		expected := dedent(`
+			SELECT
+			  time_bucket('5 minutes', ts),
+			  a <~> b
+			FROM
+			  metrics
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps base dialect behaviour", func(t *testing.T) {
+		result := format("SELECT a::int FROM t WHERE b ILIKE 'x%' LIMIT 1")
+		expected := dedent(`
+			SELECT
+			  a::int
+			FROM
+			  t
+			WHERE
+			  b ILIKE 'x%'
+			LIMIT
+			  1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("removes reserved clauses", func(t *testing.T) {
+		dialect := ExtendDialect(PostgresqlDialect, DialectOverrides{RemoveReservedClauses: []string{"LIMIT"}})
+		result, err := FormatDialect("SELECT a FROM t LIMIT 1", FormatOptionsWithDialect{Dialect: dialect})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			SELECT
+			  a
+			FROM
+			  t LIMIT 1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps oneline clauses of the base tabular when adding tabular clauses", func(t *testing.T) {
+		// without tabularOnelineClauses all oneline clauses are tabular
+		base, err := ParseDialectJSON([]byte(`{"name": "oneline-only", "reservedSelect": ["SELECT"], "onelineClauses": ["CREATE TABLE"]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		dialect := ExtendDialect(base, DialectOverrides{AddTabularOnelineClauses: []string{"TRUNCATE TABLE"}})
+		tabular := strings.Join(dialect.FormatOptions.TabularOnelineClauses, ", ")
+		if tabular != "CREATE TABLE, TRUNCATE TABLE" {
+			t.Fatalf("expected CREATE TABLE and TRUNCATE TABLE to be tabular, got %q", tabular)
+		}
+	})
+
+	t.Run("does not modify the base dialect", func(t *testing.T) {
+		contains := func(list []string, want string) bool {
+			for _, item := range list {
+				if item == want {
+					return true
+				}
+			}
+			return false
+		}
+		if !contains(timescale.TokenizerOptions.ReservedFunctionNames, "TIME_BUCKET") {
+			t.Fatal("expected TIME_BUCKET in the extended dialect")
+		}
+		if contains(PostgresqlDialect.TokenizerOptions.ReservedFunctionNames, "TIME_BUCKET") {
+			t.Fatal("base dialect was modified")
+		}
+	})
+
+	t.Run("derives a stable name for unnamed extensions", func(t *testing.T) {
+		a := ExtendDialect(PostgresqlDialect, DialectOverrides{AddFunctions: []string{"TIME_BUCKET"}})
+		b := ExtendDialect(PostgresqlDialect, DialectOverrides{AddFunctions: []string{"TIME_BUCKET"}})
+		c := ExtendDialect(PostgresqlDialect, DialectOverrides{AddFunctions: []string{"FIRST"}})
+		if a.Name != b.Name {
+			t.Fatalf("expected equal names, got %q and %q", a.Name, b.Name)
+		}
+		if a.Name == c.Name {
+			t.Fatalf("expected different names for different overrides, got %q", a.Name)
+		}
+		if CreateDialect(a) != CreateDialect(b) {
+			t.Fatal("expected equal extensions to share a cached dialect")
+		}
+	})
+}
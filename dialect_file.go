@@ -0,0 +1,334 @@
+package sqlformatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DialectFile is the declarative form of DialectOptions, as read from a JSON
+// or YAML dialect file. Field names follow the camelCase option names of the
+// upstream sql-formatter dialect definitions.
+//
+// The reserved* phrase lists and the oneline clause lists may use the
+// [optional] and {alternative | syntax} understood by ExpandPhrases. Oneline
+// clauses are added to the reserved clauses, so they need not be listed twice.
+type DialectFile struct {
+	Name string `json:"name"`
+
+	ReservedSelect          []string `json:"reservedSelect"`
+	ReservedClauses         []string `json:"reservedClauses"`
+	OnelineClauses          []string `json:"onelineClauses"`
+	TabularOnelineClauses   []string `json:"tabularOnelineClauses"`
+	ReservedSetOperations   []string `json:"reservedSetOperations"`
+	ReservedJoins           []string `json:"reservedJoins"`
+	ReservedKeywordPhrases  []string `json:"reservedKeywordPhrases"`
+	ReservedDataTypePhrases []string `json:"reservedDataTypePhrases"`
+	ReservedKeywords        []string `json:"reservedKeywords"`
+	ReservedDataTypes       []string `json:"reservedDataTypes"`
+	ReservedFunctionNames   []string `json:"reservedFunctionNames"`
+	SupportsXor             bool     `json:"supportsXor"`
+
+	StringTypes   []DialectFileQuoteType `json:"stringTypes"`
+	IdentTypes    []DialectFileQuoteType `json:"identTypes"`
+	VariableTypes []DialectFileQuoteType `json:"variableTypes"`
+
+	ExtraParens             []string               `json:"extraParens"`
+	ParamTypes              *DialectFileParamTypes `json:"paramTypes"`
+	LineCommentTypes        []string               `json:"lineCommentTypes"`
+	NestedBlockComments     bool                   `json:"nestedBlockComments"`
+	IdentChars              *DialectFileIdentChars `json:"identChars"`
+	ParamChars              *DialectFileIdentChars `json:"paramChars"`
+	Operators               []string               `json:"operators"`
+	PropertyAccessOperators []string               `json:"propertyAccessOperators"`
+	OperatorKeyword         bool                   `json:"operatorKeyword"`
+	UnderscoresInNumbers    bool                   `json:"underscoresInNumbers"`
+	AlwaysDenseOperators    []string               `json:"alwaysDenseOperators"`
+}
+
+// DialectFileQuoteType is a quote type in a dialect file: either a plain
+// string such as "$$", or an object with a quote and prefixes, or an
+// object with a regex (only meaningful for variableTypes).
+type DialectFileQuoteType struct {
+	Quote         string   `json:"quote"`
+	Prefixes      []string `json:"prefixes"`
+	RequirePrefix bool     `json:"requirePrefix"`
+	Regex         string   `json:"regex"`
+}
+
+func (q *DialectFileQuoteType) UnmarshalJSON(data []byte) error {
+	var plain string
+	if err := json.Unmarshal(data, &plain); err == nil {
+		*q = DialectFileQuoteType{Quote: plain}
+		return nil
+	}
+	type object DialectFileQuoteType
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*q = DialectFileQuoteType(obj)
+	return nil
+}
+
+type DialectFileParamTypes struct {
+	Positional bool     `json:"positional"`
+	Numbered   []string `json:"numbered"`
+	Named      []string `json:"named"`
+	Quoted     []string `json:"quoted"`
+	Typed      bool     `json:"typed"`
+	Custom     []struct {
+		Regex string `json:"regex"`
+	} `json:"custom"`
+}
+
+type DialectFileIdentChars struct {
+	First                string `json:"first"`
+	Rest                 string `json:"rest"`
+	Dashes               bool   `json:"dashes"`
+	AllowFirstCharNumber bool   `json:"allowFirstCharNumber"`
+}
+
+var knownQuoteTypes = map[string]bool{
+	"''-qq": true, "''-bs": true, "''-qq-bs": true, "''-raw": true,
+	"\"\"-qq": true, "\"\"-bs": true, "\"\"-qq-bs": true, "\"\"-raw": true,
+	"$$": true, "``": true, "[]": true, "'''..'''": true, `""".."""`: true, "q''": true,
+}
+
+// LoadDialectFile reads a dialect definition from a .json, .yaml or .yml file.
+func LoadDialectFile(path string) (DialectOptions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DialectOptions{}, err
+	}
+	var options DialectOptions
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		options, err = ParseDialectYAML(data)
+	default:
+		options, err = ParseDialectJSON(data)
+	}
+	if err != nil {
+		return DialectOptions{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return options, nil
+}
+
+// ParseDialectJSON builds DialectOptions from a JSON dialect definition.
+func ParseDialectJSON(data []byte) (DialectOptions, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var file DialectFile
+	if err := decoder.Decode(&file); err != nil {
+		return DialectOptions{}, ConfigError{Message: fmt.Sprintf("invalid dialect file: %v", err)}
+	}
+	return file.DialectOptions()
+}
+
+// ParseDialectYAML builds DialectOptions from a YAML dialect definition. It
+// accepts the same keys as the JSON form.
+func ParseDialectYAML(data []byte) (DialectOptions, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return DialectOptions{}, ConfigError{Message: fmt.Sprintf("invalid dialect file: %v", err)}
+	}
+	converted, err := json.Marshal(raw)
+	if err != nil {
+		return DialectOptions{}, ConfigError{Message: fmt.Sprintf("invalid dialect file: %v", err)}
+	}
+	return ParseDialectJSON(converted)
+}
+
+// DialectOptions validates the definition and converts it into the options
+// accepted by CreateDialect and FormatDialect.
+func (f DialectFile) DialectOptions() (DialectOptions, error) {
+	if f.Name == "" {
+		return DialectOptions{}, ConfigError{Message: "invalid dialect file: name is required"}
+	}
+	if len(f.ReservedSelect) == 0 {
+		return DialectOptions{}, ConfigError{Message: "invalid dialect file: reservedSelect is required"}
+	}
+	if err := f.checkPhrases(); err != nil {
+		return DialectOptions{}, err
+	}
+	if f.ParamTypes != nil {
+		for _, custom := range f.ParamTypes.Custom {
+			if err := checkRegex("paramTypes.custom", custom.Regex); err != nil {
+				return DialectOptions{}, err
+			}
+		}
+	}
+	stringTypes, err := f.quoteTypes("stringTypes", f.StringTypes, false)
+	if err != nil {
+		return DialectOptions{}, err
+	}
+	identTypes, err := f.quoteTypes("identTypes", f.IdentTypes, false)
+	if err != nil {
+		return DialectOptions{}, err
+	}
+	variableQuotes, err := f.quoteTypes("variableTypes", f.VariableTypes, true)
+	if err != nil {
+		return DialectOptions{}, err
+	}
+	var variableTypes []VariableType
+	for _, v := range variableQuotes {
+		variableTypes = append(variableTypes, v)
+	}
+	for _, pair := range f.ExtraParens {
+		if pair != "[]" && pair != "{}" {
+			return DialectOptions{}, ConfigError{Message: fmt.Sprintf("invalid dialect file: unsupported extraParens %q", pair)}
+		}
+	}
+	if len(stringTypes) == 0 {
+		stringTypes = []QuoteType{PlainQuoteType("''-qq")}
+	}
+	if len(identTypes) == 0 {
+		identTypes = []QuoteType{PlainQuoteType("\"\"-qq")}
+	}
+
+	oneline := ExpandPhrases(f.OnelineClauses)
+	tabular := ExpandPhrases(f.TabularOnelineClauses)
+	return DialectOptions{
+		Name: f.Name,
+		TokenizerOptions: TokenizerOptions{
+			ReservedSelect:          ExpandPhrases(f.ReservedSelect),
+			ReservedClauses:         append(append(append([]string{}, ExpandPhrases(f.ReservedClauses)...), oneline...), tabular...),
+			SupportsXor:             f.SupportsXor,
+			ReservedSetOperations:   ExpandPhrases(f.ReservedSetOperations),
+			ReservedJoins:           ExpandPhrases(f.ReservedJoins),
+			ReservedKeywordPhrases:  ExpandPhrases(f.ReservedKeywordPhrases),
+			ReservedDataTypePhrases: ExpandPhrases(f.ReservedDataTypePhrases),
+			ReservedKeywords:        f.ReservedKeywords,
+			ReservedDataTypes:       f.ReservedDataTypes,
+			ReservedFunctionNames:   f.ReservedFunctionNames,
+			StringTypes:             stringTypes,
+			IdentTypes:              identTypes,
+			VariableTypes:           variableTypes,
+			ExtraParens:             f.ExtraParens,
+			ParamTypes:              f.ParamTypes.paramTypes(),
+			LineCommentTypes:        f.LineCommentTypes,
+			NestedBlockComments:     f.NestedBlockComments,
+			IdentChars:              f.IdentChars.identChars(),
+			ParamChars:              f.ParamChars.identChars(),
+			Operators:               f.Operators,
+			PropertyAccessOperators: f.PropertyAccessOperators,
+			OperatorKeyword:         f.OperatorKeyword,
+			UnderscoresInNumbers:    f.UnderscoresInNumbers,
+		},
+		FormatOptions: DialectFormatOptions{
+			AlwaysDenseOperators:  f.AlwaysDenseOperators,
+			OnelineClauses:        append(append([]string{}, oneline...), tabular...),
+			TabularOnelineClauses: tabular,
+		},
+	}, nil
+}
+
+func (f DialectFile) quoteTypes(field string, specs []DialectFileQuoteType, allowRegex bool) ([]QuoteType, error) {
+	var out []QuoteType
+	for _, spec := range specs {
+		switch {
+		case spec.Regex != "":
+			if !allowRegex {
+				return nil, ConfigError{Message: fmt.Sprintf("invalid dialect file: regex is not allowed in %s", field)}
+			}
+			if err := checkRegex(field, spec.Regex); err != nil {
+				return nil, err
+			}
+			out = append(out, RegexPattern{Regex: spec.Regex})
+		case !knownQuoteTypes[spec.Quote]:
+			return nil, ConfigError{Message: fmt.Sprintf("invalid dialect file: unknown quote type %q in %s", spec.Quote, field)}
+		case len(spec.Prefixes) > 0:
+			out = append(out, PrefixedQuoteType{Quote: PlainQuoteType(spec.Quote), Prefixes: spec.Prefixes, RequirePrefix: spec.RequirePrefix})
+		default:
+			out = append(out, PlainQuoteType(spec.Quote))
+		}
+	}
+	return out, nil
+}
+
+// checkPhrases checks that the phrase lists can be expanded by ExpandPhrases,
+// which panics on malformed phrases.
+func (f DialectFile) checkPhrases() error {
+	fields := []struct {
+		name    string
+		phrases []string
+	}{
+		{"reservedSelect", f.ReservedSelect},
+		{"reservedClauses", f.ReservedClauses},
+		{"onelineClauses", f.OnelineClauses},
+		{"tabularOnelineClauses", f.TabularOnelineClauses},
+		{"reservedSetOperations", f.ReservedSetOperations},
+		{"reservedJoins", f.ReservedJoins},
+		{"reservedKeywordPhrases", f.ReservedKeywordPhrases},
+		{"reservedDataTypePhrases", f.ReservedDataTypePhrases},
+	}
+	for _, field := range fields {
+		for _, phrase := range field.phrases {
+			if err := checkPhrase(phrase); err != "" {
+				return ConfigError{Message: fmt.Sprintf("invalid dialect file: %s in %s phrase %q", err, field.name, phrase)}
+			}
+		}
+	}
+	return nil
+}
+
+// checkPhrase describes what keeps phrase from being expanded, or returns ""
+// if it can be.
+func checkPhrase(phrase string) string {
+	var open []byte
+	for i := 0; i < len(phrase); i++ {
+		switch ch := phrase[i]; {
+		case ch == '[' || ch == '{':
+			open = append(open, ch)
+		case ch == ']' || ch == '}':
+			if len(open) == 0 || (open[len(open)-1] == '[') != (ch == ']') {
+				return fmt.Sprintf("unbalanced %q", ch)
+			}
+			open = open[:len(open)-1]
+		case (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '_' || ch == ' ' || ch == '|':
+		default:
+			return fmt.Sprintf("unexpected %q", ch)
+		}
+	}
+	if len(open) > 0 {
+		return fmt.Sprintf("unclosed %q", open[len(open)-1])
+	}
+	return ""
+}
+
+func checkRegex(field, pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return ConfigError{Message: fmt.Sprintf("invalid dialect file: invalid regex in %s: %v", field, err)}
+	}
+	return nil
+}
+
+func (p *DialectFileParamTypes) paramTypes() *ParamTypes {
+	if p == nil {
+		return nil
+	}
+	out := &ParamTypes{
+		Positional: p.Positional,
+		Numbered:   p.Numbered,
+		Named:      p.Named,
+		Quoted:     p.Quoted,
+		Typed:      p.Typed,
+	}
+	for _, custom := range p.Custom {
+		out.Custom = append(out.Custom, CustomParameter{Regex: custom.Regex})
+	}
+	return out
+}
+
+func (c *DialectFileIdentChars) identChars() *IdentChars {
+	if c == nil {
+		return nil
+	}
+	return &IdentChars{First: c.First, Rest: c.Rest, Dashes: c.Dashes, AllowFirstCharNumber: c.AllowFirstCharNumber}
+}
@@ -0,0 +1,139 @@
+package sqlformatter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testDialectJSON = `{
+  "name": "dialect-file-json",
+  "reservedSelect": ["SELECT [ALL | DISTINCT]"],
+  "reservedClauses": ["FROM", "WHERE", "GROUP BY", "ORDER BY", "LIMIT"],
+  "tabularOnelineClauses": ["CREATE [TEMP] TABLE"],
+  "reservedSetOperations": ["UNION [ALL]"],
+  "reservedJoins": ["[LEFT] JOIN"],
+  "reservedKeywords": ["AND", "AS", "ON", "OR"],
+  "reservedFunctionNames": ["COUNT"],
+  "stringTypes": ["''-qq", {"quote": "''-raw", "prefixes": ["R"], "requirePrefix": true}],
+  "identTypes": ["` + "``" + `"],
+  "paramTypes": {"named": ["@"]},
+  "lineCommentTypes": ["--", "#"],
+  "operators": ["=>"]
+}`
+
+const testDialectYAML = `
+name: dialect-file-yaml
+reservedSelect:
+  - SELECT [ALL | DISTINCT]
+reservedClauses: [FROM, WHERE]
+reservedKeywords: [AND, OR]
+stringTypes: ["''-qq"]
+identTypes: ['""-qq']
+variableTypes:
+  - regex: '\$\{[^{}]+\}'
+`
+
+func TestDialectFile(t *testing.T) {
+	t.Run("loads a JSON dialect definition", func(t *testing.T) {
+		dialect, err := ParseDialectJSON([]byte(testDialectJSON))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result, err := FormatDialect("select count(*) from `tbl` # comment\nwhere a = @x and b = r'\\d' union all select 1", FormatOptionsWithDialect{Dialect: dialect})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			select
+			  count(*)
+			from
+			  ` + "`tbl`" + ` # comment
+			where
+			  a = @x
+			  and b = r'\d'
+			union all
+			select
+			  1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats tabular oneline clauses from the definition", func(t *testing.T) {
+		dialect, err := ParseDialectJSON([]byte(testDialectJSON))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result, err := FormatDialect("create temp table foo (id int)", FormatOptionsWithDialect{Dialect: dialect})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertEqual(t, result, "create temp table foo (id int)")
+	})
+
+	t.Run("loads a YAML dialect definition from a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "dialect.yaml")
+		if err := os.WriteFile(path, []byte(testDialectYAML), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		dialect, err := LoadDialectFile(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result, err := FormatDialect("SELECT ${col} FROM t WHERE x = 1", FormatOptionsWithDialect{Dialect: dialect})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			SELECT
+			  ${col}
+			FROM
+			  t
+			WHERE
+			  x = 1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("rejects invalid definitions", func(t *testing.T) {
+		cases := map[string]string{
+			"missing name":       `{"reservedSelect": ["SELECT"]}`,
+			"missing select":     `{"name": "x"}`,
+			"unknown quote type": `{"name": "x", "reservedSelect": ["SELECT"], "stringTypes": ["%%"]}`,
+			"regex string type":  `{"name": "x", "reservedSelect": ["SELECT"], "stringTypes": [{"regex": "a"}]}`,
+			"unknown field":      `{"name": "x", "reservedSelect": ["SELECT"], "keywords": []}`,
+			"bad extra parens":   `{"name": "x", "reservedSelect": ["SELECT"], "extraParens": ["<>"]}`,
+		}
+		for name, input := range cases {
+			_, err := ParseDialectJSON([]byte(input))
+			var configErr ConfigError
+			if !errors.As(err, &configErr) {
+				t.Errorf("%s: expected ConfigError, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("rejects malformed regexes and phrases naming the field", func(t *testing.T) {
+		cases := map[string]string{
+			"paramTypes.custom":      `{"name": "x", "reservedSelect": ["SELECT"], "paramTypes": {"custom": [{"regex": "(["}]}}`,
+			"variableTypes":          `{"name": "x", "reservedSelect": ["SELECT"], "variableTypes": [{"regex": "(["}]}`,
+			"reservedSelect":         `{"name": "x", "reservedSelect": ["SELECT [ALL"]}`,
+			"reservedClauses":        `{"name": "x", "reservedSelect": ["SELECT"], "reservedClauses": ["FROM]"]}`,
+			"reservedKeywordPhrases": `{"name": "x", "reservedSelect": ["SELECT"], "reservedKeywordPhrases": ["ON {DELETE | UPDATE]"]}`,
+			"reservedJoins":          `{"name": "x", "reservedSelect": ["SELECT"], "reservedJoins": ["LEFT-JOIN"]}`,
+		}
+		for field, input := range cases {
+			_, err := ParseDialectJSON([]byte(input))
+			var configErr ConfigError
+			if !errors.As(err, &configErr) {
+				t.Errorf("%s: expected ConfigError, got %v", field, err)
+				continue
+			}
+			if !strings.Contains(configErr.Message, " "+field) {
+				t.Errorf("%s: expected the error to name the field, got %q", field, configErr.Message)
+			}
+		}
+	})
+}
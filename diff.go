@@ -0,0 +1,337 @@
+package sqlformatter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FormatEdits formats query like Format and returns the edits that turn query
+// into the formatted text, instead of the whole text. A trailing newline of
+// query is kept.
+func FormatEdits(query string, cfg FormatOptionsWithLanguage) ([]TextEdit, error) {
+	formatter, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return formatter.FormatEdits(query)
+}
+
+// FormatEdits is like the package-level FormatEdits.
+func (f *Formatter) FormatEdits(query string) ([]TextEdit, error) {
+	return f.FormatEditsContext(context.Background(), query)
+}
+
+// FormatEditsContext is like FormatEdits but stops with ctx.Err() once ctx is
+// done, and enforces the configured Limits.
+func (f *Formatter) FormatEditsContext(ctx context.Context, query string) ([]TextEdit, error) {
+	formatted, err := f.FormatContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(query, "\n") && formatted != "" {
+		formatted += "\n"
+	}
+	return Edits(query, formatted), nil
+}
+
+// Edits returns the edits that turn before into after, as whole lines that
+// were changed, in order and without overlap.
+func Edits(before, after string) []TextEdit {
+	a, b := splitLines(before), splitLines(after)
+	var edits []TextEdit
+	offset := 0
+	var edit *TextEdit
+	for _, op := range diffLines(a, b) {
+		if op.kind == diffEqual {
+			for _, line := range a[op.a1:op.a2] {
+				offset += len(line)
+			}
+			edit = nil
+			continue
+		}
+		if edit == nil {
+			edits = append(edits, TextEdit{Start: offset, End: offset})
+			edit = &edits[len(edits)-1]
+		}
+		switch op.kind {
+		case diffDelete:
+			for _, line := range a[op.a1:op.a2] {
+				offset += len(line)
+			}
+			edit.End = offset
+		case diffInsert:
+			edit.NewText += strings.Join(b[op.b1:op.b2], "")
+		}
+	}
+	return edits
+}
+
+// unifiedContext is the number of unchanged lines shown around each change
+// in UnifiedDiff.
+const unifiedContext = 3
+
+// UnifiedDiff returns the differences between before and after in unified
+// diff format, labelled with the file names oldName and newName, or an empty
+// string when they are equal.
+func UnifiedDiff(oldName, newName, before, after string) string {
+	a, b := splitLines(before), splitLines(after)
+	type diffLine struct {
+		kind diffKind
+		text string
+		// aLine and bLine count the lines of before and after that come
+		// before this one
+		aLine, bLine int
+	}
+	var lines []diffLine
+	changed := false
+	for _, op := range diffLines(a, b) {
+		switch op.kind {
+		case diffEqual:
+			for i := op.a1; i < op.a2; i++ {
+				lines = append(lines, diffLine{diffEqual, a[i], i, op.b1 + i - op.a1})
+			}
+		case diffDelete:
+			changed = true
+			for i := op.a1; i < op.a2; i++ {
+				lines = append(lines, diffLine{diffDelete, a[i], i, op.b1})
+			}
+		case diffInsert:
+			changed = true
+			for i := op.b1; i < op.b2; i++ {
+				lines = append(lines, diffLine{diffInsert, b[i], op.a1, i})
+			}
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", oldName, newName)
+	for i := 0; i < len(lines); {
+		if lines[i].kind == diffEqual {
+			i++
+			continue
+		}
+		// a hunk takes in the following changes that are at most twice
+		// the context apart
+		start := max(0, i-unifiedContext)
+		end := i + 1
+		for j := i + 1; j < len(lines); j++ {
+			if lines[j].kind != diffEqual {
+				end = j + 1
+			} else if j-end >= 2*unifiedContext {
+				break
+			}
+		}
+		stop := min(len(lines), end+unifiedContext)
+
+		aCount, bCount := 0, 0
+		for _, line := range lines[start:stop] {
+			if line.kind != diffInsert {
+				aCount++
+			}
+			if line.kind != diffDelete {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(lines[start].aLine, aCount), hunkRange(lines[start].bLine, bCount))
+		for _, line := range lines[start:stop] {
+			switch line.kind {
+			case diffEqual:
+				writeDiffLine(&out, " ", line.text)
+			case diffDelete:
+				writeDiffLine(&out, "-", line.text)
+			case diffInsert:
+				writeDiffLine(&out, "+", line.text)
+			}
+		}
+		i = stop
+	}
+	return out.String()
+}
+
+// hunkRange formats the range of count lines after the first lines of a
+// file, as in a unified diff hunk header.
+func hunkRange(first, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", first)
+	case 1:
+		return fmt.Sprintf("%d", first+1)
+	default:
+		return fmt.Sprintf("%d,%d", first+1, count)
+	}
+}
+
+func writeDiffLine(out *strings.Builder, prefix string, line string) {
+	out.WriteString(prefix)
+	out.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		out.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+// splitLines splits text after each newline, keeping the newlines.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+)
+
+// diffOp is a run of lines a[a1:a2] that are equal to b[b1:b2], deleted from
+// a, or inserted from b.
+type diffOp struct {
+	kind   diffKind
+	a1, a2 int
+	b1, b2 int
+}
+
+// maxDiffDistance bounds the work diffLines does. Inputs that differ in more
+// lines than this past their common prefix and suffix are reported as one
+// replacement, as the shortest edit script would cost quadratic memory.
+const maxDiffDistance = 1000
+
+// diffLines returns the shortest edit script from a to b, computed with
+// Myers' algorithm, as runs of equal, deleted and inserted lines.
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []diffOp
+	add := func(kind diffKind, a1, a2, b1, b2 int) {
+		if a1 == a2 && b1 == b2 {
+			return
+		}
+		if n := len(ops); n > 0 && ops[n-1].kind == kind && ops[n-1].a2 == a1 && ops[n-1].b2 == b1 {
+			ops[n-1].a2, ops[n-1].b2 = a2, b2
+			return
+		}
+		ops = append(ops, diffOp{kind: kind, a1: a1, a2: a2, b1: b1, b2: b2})
+	}
+
+	add(diffEqual, 0, prefix, 0, prefix)
+	aEnd, bEnd := len(a)-suffix, len(b)-suffix
+	if path, ok := myersPath(a[prefix:aEnd], b[prefix:bEnd]); ok {
+		x, y := 0, 0
+		for _, point := range path {
+			// each step is a deletion or insertion followed by a diagonal
+			// of equal lines
+			switch {
+			case point.x-x > point.y-y:
+				add(diffDelete, prefix+x, prefix+x+1, prefix+y, prefix+y)
+				x++
+			case point.y-y > point.x-x:
+				add(diffInsert, prefix+x, prefix+x, prefix+y, prefix+y+1)
+				y++
+			}
+			add(diffEqual, prefix+x, prefix+point.x, prefix+y, prefix+point.y)
+			x, y = point.x, point.y
+		}
+	} else {
+		add(diffDelete, prefix, aEnd, prefix, prefix)
+		add(diffInsert, aEnd, aEnd, prefix, bEnd)
+	}
+	add(diffEqual, aEnd, len(a), bEnd, len(b))
+	return groupChanges(ops)
+}
+
+// groupChanges rewrites each run of interleaved deletions and insertions as
+// one deletion followed by one insertion, as diff tools show them.
+func groupChanges(ops []diffOp) []diffOp {
+	grouped := make([]diffOp, 0, len(ops))
+	for i := 0; i < len(ops); {
+		if ops[i].kind == diffEqual {
+			grouped = append(grouped, ops[i])
+			i++
+			continue
+		}
+		first := ops[i]
+		last := first
+		for i < len(ops) && ops[i].kind != diffEqual {
+			last = ops[i]
+			i++
+		}
+		if first.a1 < last.a2 {
+			grouped = append(grouped, diffOp{kind: diffDelete, a1: first.a1, a2: last.a2, b1: first.b1, b2: first.b1})
+		}
+		if first.b1 < last.b2 {
+			grouped = append(grouped, diffOp{kind: diffInsert, a1: last.a2, a2: last.a2, b1: first.b1, b2: last.b2})
+		}
+	}
+	return grouped
+}
+
+type diffPoint struct{ x, y int }
+
+// myersPath returns the end points of the snakes of a shortest edit script
+// from a to b, or false when the edit distance exceeds maxDiffDistance.
+func myersPath(a, b []string) ([]diffPoint, bool) {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxDiffDistance {
+		limit = maxDiffDistance
+	}
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, offset, d, n, m), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// backtrack walks the saved V arrays of myersPath back from the end point
+// reached after d edits.
+func backtrack(trace [][]int, offset, d, x, y int) []diffPoint {
+	path := make([]diffPoint, d+1)
+	path[d] = diffPoint{x, y}
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		x = v[offset+prevK]
+		y = x - prevK
+		path[d-1] = diffPoint{x, y}
+	}
+	return path
+}
@@ -0,0 +1,106 @@
+package sqlformatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEdits(t *testing.T) {
+	apply := func(text string, edits []TextEdit) string {
+		for i := len(edits) - 1; i >= 0; i-- {
+			text = text[:edits[i].Start] + edits[i].NewText + text[edits[i].End:]
+		}
+		return text
+	}
+
+	t.Run("replaces only the changed lines", func(t *testing.T) {
+		before := "a\nb\nc\nd\ne\n"
+		after := "a\nB\nc\nd\ne\nf\n"
+		edits := Edits(before, after)
+		if len(edits) != 2 {
+			t.Fatalf("expected 2 edits, got %v", edits)
+		}
+		if edits[0] != (TextEdit{Start: 2, End: 4, NewText: "B\n"}) || edits[1] != (TextEdit{Start: 10, End: 10, NewText: "f\n"}) {
+			t.Errorf("unexpected edits %v", edits)
+		}
+		assertEqual(t, apply(before, edits), after)
+	})
+
+	t.Run("returns no edits for equal text", func(t *testing.T) {
+		if edits := Edits("a\nb", "a\nb"); len(edits) != 0 {
+			t.Errorf("expected no edits, got %v", edits)
+		}
+	})
+
+	t.Run("turns one text into the other", func(t *testing.T) {
+		pairs := [][2]string{
+			{"", "a\n"},
+			{"a\n", ""},
+			{"a\nb\nc", "c\nb\na"},
+			{"a\nb\na\nb\n", "b\na\nb\na"},
+			{"x\ny\nz\n", "x\n1\ny\n2\nz\n3\n"},
+		}
+		for _, pair := range pairs {
+			assertEqual(t, apply(pair[0], Edits(pair[0], pair[1])), pair[1])
+		}
+	})
+
+	t.Run("formats a query into edits", func(t *testing.T) {
+		query := "-- keep\nselect a from b;\n"
+		edits, err := FormatEdits(query, FormatOptionsWithLanguage{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(edits) != 1 || edits[0].Start != len("-- keep\n") || edits[0].End != len(query) {
+			t.Fatalf("unexpected edits %v", edits)
+		}
+		assertEqual(t, apply(query, edits), "-- keep\nselect\n  a\nfrom\n  b;\n")
+	})
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("renders changes with context", func(t *testing.T) {
+		before := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+		after := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n"
+		expected := strings.Join([]string{
+			"--- a.sql",
+			"+++ b.sql",
+			"@@ -1,6 +1,6 @@",
+			" 1",
+			" 2",
+			"-3",
+			"+three",
+			" 4",
+			" 5",
+			" 6",
+			"@@ -10,3 +10,4 @@",
+			" 10",
+			" 11",
+			" 12",
+			"+13",
+			"",
+		}, "\n")
+		assertEqual(t, UnifiedDiff("a.sql", "b.sql", before, after), expected)
+	})
+
+	t.Run("marks a missing newline at the end", func(t *testing.T) {
+		expected := strings.Join([]string{
+			"--- q.sql",
+			"+++ q.sql",
+			"@@ -1,2 +1,4 @@",
+			"-select a",
+			"-from b;",
+			"+select",
+			"+  a",
+			"+from",
+			"+  b;",
+			"\\ No newline at end of file",
+			"",
+		}, "\n")
+		assertEqual(t, UnifiedDiff("q.sql", "q.sql", "select a\nfrom b;\n", "select\n  a\nfrom\n  b;"), expected)
+	})
+
+	t.Run("is empty for equal text", func(t *testing.T) {
+		assertEqual(t, UnifiedDiff("a", "b", "x\n", "x\n"), "")
+	})
+}
@@ -30,10 +30,10 @@ func mapTokensInPlace(tokens []Token, fn func(Token, int, []Token) Token) {
 func propertyNameKeywordToIdent(token Token, i int, tokens []Token) Token {
 	if IsReserved(token.Type) {
 		if prev := prevNonCommentToken(tokens, i); prev.Type != "" && prev.Type == TokenPropertyAccessOperator {
-			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, PrecedingWhitespace: token.PrecedingWhitespace}
+			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, End: token.End, PrecedingWhitespace: token.PrecedingWhitespace}
 		}
 		if next := nextNonCommentToken(tokens, i); next.Type != "" && next.Type == TokenPropertyAccessOperator {
-			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, PrecedingWhitespace: token.PrecedingWhitespace}
+			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, End: token.End, PrecedingWhitespace: token.PrecedingWhitespace}
 		}
 	}
 	return token
@@ -43,7 +43,7 @@ func funcNameToIdent(token Token, i int, tokens []Token) Token {
 	if token.Type == TokenReservedFunctionName {
 		next := nextNonCommentToken(tokens, i)
 		if next.Type == "" || !isOpenParen(next) {
-			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, PrecedingWhitespace: token.PrecedingWhitespace}
+			return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, End: token.End, PrecedingWhitespace: token.PrecedingWhitespace}
 		}
 	}
 	return token
@@ -65,7 +65,7 @@ func commentTableAfterJoinToIdent(token Token, i int, tokens []Token) Token {
 	} else if !strings.Contains(strings.ToUpper(token.Raw), "COMMENT ON") {
 		return token
 	}
-	return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, PrecedingWhitespace: token.PrecedingWhitespace}
+	return Token{Type: TokenIdentifier, Raw: token.Raw, Text: token.Raw, Start: token.Start, End: token.End, PrecedingWhitespace: token.PrecedingWhitespace}
 }
 
 // sqlcFunctionToReservedFunctionName converts identifiers to reserved function names
@@ -100,7 +100,7 @@ func sqlcFunctionToReservedFunctionName(token Token, i int, tokens []Token) Toke
 	}
 	// sqlc.arg() and sqlc.embed() are normalized without a space before the
 	// parenthesis by sql-formatter's JavaScript PostgreSQL formatter.
-	return Token{Type: TokenReservedFunctionName, Raw: token.Raw, Text: token.Text, Start: token.Start, PrecedingWhitespace: token.PrecedingWhitespace}
+	return Token{Type: TokenReservedFunctionName, Raw: token.Raw, Text: token.Text, Start: token.Start, End: token.End, PrecedingWhitespace: token.PrecedingWhitespace}
 }
 
 // findPrevNonComment returns the index of the previous non-comment token, or -1 if not found
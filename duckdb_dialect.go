@@ -0,0 +1,144 @@
+package sqlformatter
+
+import (
+	"strings"
+
+	duckdb "sql-formatter-go/languages/duckdb"
+	postgresql "sql-formatter-go/languages/postgresql"
+)
+
+// DuckDB follows the PostgreSQL grammar and extends it with FROM-first
+// queries, QUALIFY, PIVOT/UNPIVOT statements, ASOF/POSITIONAL joins and
+// list/struct literals.
+var duckdbReservedClausesPhrases = append(append([]string{}, postgresqlReservedClausesPhrases...),
+	"GROUP BY ALL",
+	"ORDER BY ALL",
+	"QUALIFY",
+	"USING SAMPLE",
+	"INSERT [OR REPLACE | OR IGNORE] INTO",
+)
+
+var duckdbStandardOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [TEMPORARY | TEMP] TABLE [IF NOT EXISTS]",
+}
+
+var duckdbTabularOnelineClausesPhrases = append(append([]string{}, postgresqlTabularOnelineClausesPhrases...),
+	"CREATE [OR REPLACE] [TEMPORARY | TEMP] {MACRO | FUNCTION}",
+	"DROP MACRO [TABLE] [IF EXISTS]",
+	"CREATE [OR REPLACE] [PERSISTENT | TEMPORARY] SECRET [IF NOT EXISTS]",
+	"DROP [PERSISTENT | TEMPORARY] SECRET [IF EXISTS]",
+	"ATTACH [DATABASE] [IF NOT EXISTS]",
+	"DETACH [DATABASE] [IF EXISTS]",
+	"DESCRIBE",
+	"EXPORT DATABASE",
+	"IMPORT DATABASE",
+	"INSTALL",
+	"PIVOT",
+	"PIVOT_WIDER",
+	"UNPIVOT",
+	"PRAGMA",
+	"SUMMARIZE",
+	"USE",
+)
+
+var duckdbReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT] [BY NAME]",
+	"EXCEPT [ALL | DISTINCT]",
+	"INTERSECT [ALL | DISTINCT]",
+}
+
+var duckdbReservedJoinsPhrases = append(append([]string{}, postgresqlReservedJoinsPhrases...),
+	"ASOF [INNER] JOIN",
+	"ASOF {LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"POSITIONAL JOIN",
+	"{LEFT | RIGHT} {SEMI | ANTI} JOIN",
+	"{SEMI | ANTI} JOIN",
+)
+
+var duckdbReservedKeywordPhrasesPhrases = append(append([]string{}, postgresqlReservedKeywordPhrasesPhrases...),
+	"SIMILAR TO",
+)
+
+var duckdbOperators = append(append([]string{}, postgresqlOperators...),
+	"//",
+	"**",
+	"==",
+	"!",
+)
+
+var DuckdbDialect = DialectOptions{
+	Name: "duckdb",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:          ExpandPhrases(postgresqlReservedSelectPhrases),
+		ReservedClauses:         append(append([]string{}, ExpandPhrases(duckdbReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(duckdbStandardOnelineClausesPhrases)...), ExpandPhrases(duckdbTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:   ExpandPhrases(duckdbReservedSetOperationsPhrases),
+		ReservedJoins:           ExpandPhrases(duckdbReservedJoinsPhrases),
+		ReservedKeywordPhrases:  ExpandPhrases(duckdbReservedKeywordPhrasesPhrases),
+		ReservedDataTypePhrases: ExpandPhrases(postgresqlReservedDataTypePhrasesPhrases),
+		ReservedKeywords:        append(append([]string{}, postgresql.Keywords...), duckdb.Keywords...),
+		ReservedDataTypes:       append(append([]string{}, postgresql.DataTypes...), duckdb.DataTypes...),
+		ReservedFunctionNames:   append(append([]string{}, postgresql.Functions...), duckdb.Functions...),
+		NestedBlockComments:     true,
+		ExtraParens:             []string{"[]", "{}"},
+		UnderscoresInNumbers:    true,
+		StringTypes: []QuoteType{
+			PlainQuoteType("$$"),
+			PlainQuoteType("''-qq"),
+			PrefixedQuoteType{Quote: PlainQuoteType("''-qq-bs"), Prefixes: []string{"E"}, RequirePrefix: true},
+			PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: []string{"B", "X"}, RequirePrefix: true},
+		},
+		IdentTypes:      []QuoteType{PlainQuoteType("\"\"-qq")},
+		IdentChars:      &IdentChars{Rest: "$"},
+		ParamTypes:      &ParamTypes{Positional: true, Numbered: []string{"$"}, Quoted: []string{"$"}},
+		Operators:       duckdbOperators,
+		OperatorKeyword: true,
+		PostProcess:     duckdbPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		AlwaysDenseOperators:  []string{"::"},
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(duckdbStandardOnelineClausesPhrases)...), ExpandPhrases(duckdbTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(duckdbTabularOnelineClausesPhrases),
+	},
+}
+
+// duckdbPostProcess treats REPLACE following a star, directly or after its
+// EXCLUDE list (SELECT * EXCLUDE (...) REPLACE (...)), as a star modifier like
+// EXCLUDE rather than as the replace() function.
+func duckdbPostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenReservedFunctionName || strings.ToUpper(token.Text) != "REPLACE" {
+			continue
+		}
+		prev := findPrevNonComment(tokens, i)
+		if prev >= 0 && tokens[prev].Type == TokenCloseParen {
+			prev = starExcludeStart(tokens, prev)
+		}
+		if prev >= 0 && tokens[prev].Type == TokenAsterisk {
+			tokens[i].Type = TokenReservedKeyword
+		}
+	}
+	return tokens
+}
+
+// starExcludeStart returns the index of the star of the * EXCLUDE (...) list
+// closed by the paren at index, or -1 if the parens close something else.
+func starExcludeStart(tokens []Token, index int) int {
+	depth := 0
+	for i := index; i >= 0; i-- {
+		switch tokens[i].Type {
+		case TokenCloseParen:
+			depth++
+		case TokenOpenParen:
+			depth--
+		}
+		if depth > 0 {
+			continue
+		}
+		exclude := findPrevNonComment(tokens, i)
+		if exclude < 0 || strings.ToUpper(tokens[exclude].Text) != "EXCLUDE" {
+			return -1
+		}
+		return findPrevNonComment(tokens, exclude)
+	}
+	return -1
+}
@@ -0,0 +1,142 @@
+package sqlformatter
+
+import "testing"
+
+func TestDuckdbFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageDuckdb, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageDuckdb, query, cfg...)
+	}
+
+	behavesLikePostgresqlFormatter(t, format)
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsConstraints(t, format, []string{"NO ACTION", "RESTRICT", "CASCADE", "SET NULL", "SET DEFAULT"})
+	supportsArrayLiterals(t, format, arrayLiteralConfig{WithArrayPrefix: true, WithoutArrayPrefix: true})
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithoutTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "X''", "B''", "E''", "$$"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq"})
+	supportsSchema(t, format)
+	supportsOperators(t, format, []string{"%", "^", "//", "**", "==", "&", "|", "<<", ">>", "->", "->>", ":=", "=>", "~~", "!~~", "^@", "||"}, operatorConfig{Any: true})
+	supportsJoin(t, format, joinOptions{SupportsUsing: true, Additionally: []string{"ASOF JOIN", "ASOF LEFT JOIN", "POSITIONAL JOIN", "SEMI JOIN", "ANTI JOIN"}})
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "UNION BY NAME", "UNION ALL BY NAME", "EXCEPT", "EXCEPT ALL", "INTERSECT", "INTERSECT ALL"})
+	supportsParams(t, format, paramConfig{Positional: true, Numbered: []string{"$"}, Quoted: []string{"$"}})
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true})
+
+	t.Run("formats FROM-first queries", func(t *testing.T) {
+		result := format("FROM tbl SELECT a, b WHERE a > 1; FROM tbl;")
+		expected := dedent(`
+			FROM
+			  tbl
+			SELECT
+			  a,
+			  b
+			WHERE
+			  a > 1;
+
+			FROM
+			  tbl;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports star EXCLUDE and REPLACE modifiers", func(t *testing.T) {
+		result := format("SELECT * EXCLUDE (a, b) FROM t; SELECT * REPLACE (a + 1 AS a) FROM t; SELECT * EXCLUDE (a, b) REPLACE (c + 1 AS c) FROM t;")
+		expected := dedent(`
+			SELECT
+			  * EXCLUDE (a, b)
+			FROM
+			  t;
+
+			SELECT
+			  * REPLACE (a + 1 AS a)
+			FROM
+			  t;
+
+			SELECT
+			  * EXCLUDE (a, b) REPLACE (c + 1 AS c)
+			FROM
+			  t;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps replace() as a function", func(t *testing.T) {
+		result := format("SELECT replace(name, 'a', 'b') FROM t")
+		expected := dedent(`
+			SELECT
+			  replace(name, 'a', 'b')
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports COLUMNS expression", func(t *testing.T) {
+		result := format("SELECT min(COLUMNS('price_.*')) FROM t")
+		expected := dedent(`
+			SELECT
+			  min(COLUMNS('price_.*'))
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports list and struct literals", func(t *testing.T) {
+		result := format("SELECT [1, 2, 3] AS l, {'a': 1, 'b': [2]} AS s FROM t")
+		expected := dedent(`
+			SELECT
+			  [1, 2, 3] AS l,
+			  {'a': 1, 'b': [2]} AS s
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports lambda functions", func(t *testing.T) {
+		result := format("SELECT list_transform(l, x -> x + 1) FROM t")
+		expected := dedent(`
+			SELECT
+			  list_transform(l, x -> x + 1)
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats PIVOT and UNPIVOT statements", func(t *testing.T) {
+		result := format("PIVOT cities ON year USING sum(population) GROUP BY country; UNPIVOT monthly ON jan INTO NAME month VALUE sales;")
+		expected := dedent(`
+			PIVOT cities ON year USING sum(population)
+			GROUP BY
+			  country;
+
+			UNPIVOT monthly ON jan INTO NAME month VALUE sales;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats QUALIFY clause", func(t *testing.T) {
+		result := format("SELECT a, row_number() OVER (PARTITION BY b) AS rn FROM t QUALIFY rn = 1")
+		expected := dedent(`
+			SELECT
+			  a,
+			  row_number() OVER (
+			    PARTITION BY
+			      b
+			  ) AS rn
+			FROM
+			  t
+			QUALIFY
+			  rn = 1
+		`)
+		assertEqual(t, result, expected)
+	})
+}
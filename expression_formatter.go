@@ -1,11 +1,13 @@
 package sqlformatter
 
 import (
+	"context"
 	"regexp"
 	"strings"
 )
 
 type ExpressionFormatter struct {
+	ctx          context.Context
 	cfg          FormatOptions
 	dialectCfg   ProcessedDialectFormatOptions
 	params       *Params
@@ -17,6 +19,9 @@ type ExpressionFormatter struct {
 }
 
 type ExpressionFormatterParams struct {
+	// Ctx, when set, is checked before each attempt to lay out an
+	// expression inline; formatting is aborted once it is done.
+	Ctx          context.Context
 	Cfg          FormatOptions
 	DialectCfg   ProcessedDialectFormatOptions
 	Params       *Params
@@ -27,6 +32,7 @@ type ExpressionFormatterParams struct {
 
 func NewExpressionFormatter(p ExpressionFormatterParams) *ExpressionFormatter {
 	return &ExpressionFormatter{
+		ctx:          p.Ctx,
 		cfg:          p.Cfg,
 		dialectCfg:   p.DialectCfg,
 		params:       p.Params,
@@ -71,6 +77,8 @@ func (f *ExpressionFormatter) formatNodeWithoutComments(node AstNode) {
 		f.formatCaseWhen(n)
 	case *CaseElseNode:
 		f.formatCaseElse(n)
+	case *BlockNode:
+		f.formatBlock(n)
 	case *ClauseNode:
 		f.formatClause(n)
 	case *SetOperationNode:
@@ -178,7 +186,7 @@ func (f *ExpressionFormatter) showParameterizedDataTypeInline(node *Parameterize
 		return strings.TrimRight(inlineLayout.ToString(), " ")
 	}
 	layout := NewLayout(NewIndentation(f.layout.GetIndentation().GetSingleIndent()))
-	formatter := NewExpressionFormatter(ExpressionFormatterParams{Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: layout, Inline: true})
+	formatter := NewExpressionFormatter(ExpressionFormatterParams{Ctx: f.ctx, Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: layout, Inline: true})
 	formatter.Format([]AstNode{node})
 	return strings.TrimRight(layout.ToString(), " ")
 }
@@ -244,6 +252,37 @@ func (f *ExpressionFormatter) formatCaseElse(node *CaseElseNode) {
 	f.layout = f.formatSubExpression(node.Result)
 }
 
+func (f *ExpressionFormatter) formatBlock(node *BlockNode) {
+	for i, section := range node.Sections {
+		f.withCommentsKeyword(&section.NameKw, func() {
+			if i == 0 && isDeclarationKw(&section.NameKw) {
+				// AS/IS stays at the end of the CREATE PROCEDURE header line
+				f.layout.Add(f.showKw(&section.NameKw))
+			} else {
+				f.layout.Add(Newline, Indent, f.showKw(&section.NameKw))
+			}
+		})
+		f.layout.GetIndentation().IncreaseTopLevel()
+		for _, statement := range section.Statements {
+			f.layout.Add(Newline, Indent)
+			f.layout = f.formatSubExpression(statement.Children)
+			if statement.HasSemicolon {
+				f.layout.Add(NoNewline, ";")
+			}
+		}
+		f.layout.GetIndentation().DecreaseTopLevel()
+	}
+	f.layout.Add(Newline, Indent)
+	f.formatNode(&node.EndKw)
+	if node.Label != nil {
+		f.formatNode(node.Label)
+	}
+}
+
+func isDeclarationKw(node *KeywordNode) bool {
+	return node.Text == "AS" || node.Text == "IS"
+}
+
 func (f *ExpressionFormatter) formatClause(node *ClauseNode) {
 	if f.isOnelineClause(node) {
 		f.formatClauseInOnelineStyle(node)
@@ -455,7 +494,7 @@ func (f *ExpressionFormatter) formatSubExpression(nodes []AstNode) LayoutWriter
 }
 
 func (f *ExpressionFormatter) formatSubExpressionWithOptions(nodes []AstNode, betweenRight bool) LayoutWriter {
-	return NewExpressionFormatter(ExpressionFormatterParams{Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: f.layout, Inline: f.inline, BetweenRight: betweenRight}).Format(nodes)
+	return NewExpressionFormatter(ExpressionFormatterParams{Ctx: f.ctx, Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: f.layout, Inline: f.inline, BetweenRight: betweenRight}).Format(nodes)
 }
 
 func (f *ExpressionFormatter) formatInlineExpression(nodes []AstNode) LayoutWriter {
@@ -463,8 +502,13 @@ func (f *ExpressionFormatter) formatInlineExpression(nodes []AstNode) LayoutWrit
 	if f.params != nil {
 		oldIndex = f.params.GetPositionalParameterIndex()
 	}
+	if f.ctx != nil {
+		if err := f.ctx.Err(); err != nil {
+			panic(formatAbort{err: err})
+		}
+	}
 	inlineLayout := NewInlineLayout(f.cfg.ExpressionWidth)
-	formatter := NewExpressionFormatter(ExpressionFormatterParams{Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: inlineLayout, Inline: true})
+	formatter := NewExpressionFormatter(ExpressionFormatterParams{Ctx: f.ctx, Cfg: f.cfg, DialectCfg: f.dialectCfg, Params: f.params, Layout: inlineLayout, Inline: true})
 	var inlineErr bool
 	defer func() {
 		if r := recover(); r != nil {
@@ -653,6 +697,8 @@ func getLeadingComments(node AstNode) []CommentNode {
 		return n.LeadingComments
 	case *CaseElseNode:
 		return n.LeadingComments
+	case *BlockNode:
+		return n.LeadingComments
 	case *LimitClauseNode:
 		return n.LeadingComments
 	case *AllColumnsAsteriskNode:
@@ -706,6 +752,8 @@ func getTrailingComments(node AstNode) []CommentNode {
 		return n.TrailingComments
 	case *CaseElseNode:
 		return n.TrailingComments
+	case *BlockNode:
+		return n.TrailingComments
 	case *LimitClauseNode:
 		return n.TrailingComments
 	case *AllColumnsAsteriskNode:
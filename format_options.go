@@ -46,6 +46,7 @@ type FormatOptions struct {
 	NewlineBeforeSemicolon bool
 	Params                 ParamItemsOrList
 	ParamTypes             *ParamTypes
+	Limits                 Limits
 }
 
 type FormatOptionsWithLanguage struct {
@@ -0,0 +1,89 @@
+package sqlformatter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TextEdit replaces the bytes from Start up to End of a text with NewText.
+type TextEdit struct {
+	Start   int
+	End     int
+	NewText string
+}
+
+// FormatRange formats the statements of query that overlap the byte range
+// from start to end, leaving the rest of query untouched. An empty range
+// formats the statement containing start. The result is the edit to apply to
+// query, or no edits when the statements are already formatted.
+func FormatRange(query string, start, end int, cfg FormatOptionsWithLanguage) ([]TextEdit, error) {
+	formatter, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return formatter.FormatRange(query, start, end)
+}
+
+// FormatRange is like the package-level FormatRange.
+func (f *Formatter) FormatRange(query string, start, end int) ([]TextEdit, error) {
+	return f.FormatRangeContext(context.Background(), query, start, end)
+}
+
+// FormatRangeContext is like FormatRange but stops with ctx.Err() once ctx is
+// done, and enforces the configured Limits.
+func (f *Formatter) FormatRangeContext(ctx context.Context, query string, start, end int) ([]TextEdit, error) {
+	if start < 0 || end < start || end > len(query) {
+		return nil, fmt.Errorf("invalid range %d-%d for input of %d bytes", start, end, len(query))
+	}
+	parser := f.newParser(ctx)
+	statements, err := parser.Parse(query, f.dialect.Tokenizer, f.cfg.ParamTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := -1, -1
+	for i, stmt := range statements {
+		if overlapsRange(stmt, start, end) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return nil, nil
+	}
+
+	params := NewParams(f.cfg.Params)
+	if f.cfg.Params != nil {
+		// positional parameters are numbered from the start of the query
+		for _, stmt := range statements[:first] {
+			if _, err := f.formatStatement(ctx, stmt, params); err != nil {
+				return nil, err
+			}
+		}
+	}
+	formatted, err := f.formatAst(ctx, statements[first:last+1], params)
+	if err != nil {
+		return nil, err
+	}
+	edit := TextEdit{
+		Start:   statements[first].Start.Offset,
+		End:     statements[last].End.Offset,
+		NewText: strings.TrimRight(formatted, " \t\n\r"),
+	}
+	if query[edit.Start:edit.End] == edit.NewText {
+		return nil, nil
+	}
+	return []TextEdit{edit}, nil
+}
+
+// overlapsRange reports whether stmt overlaps the byte range from start to
+// end, or contains start when the range is empty.
+func overlapsRange(stmt *StatementNode, start, end int) bool {
+	if start == end {
+		return stmt.Start.Offset <= start && start <= stmt.End.Offset
+	}
+	return stmt.Start.Offset < end && start < stmt.End.Offset
+}
@@ -0,0 +1,95 @@
+package sqlformatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatRange(t *testing.T) {
+	apply := func(query string, edits []TextEdit) string {
+		for i := len(edits) - 1; i >= 0; i-- {
+			query = query[:edits[i].Start] + edits[i].NewText + query[edits[i].End:]
+		}
+		return query
+	}
+	formatRange := func(t *testing.T, query string, start, end int, cfg FormatOptionsWithLanguage) []TextEdit {
+		t.Helper()
+		edits, err := FormatRange(query, start, end, cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return edits
+	}
+
+	query := "select a from b;\n\n  select c from d where e = 1;\nselect f from g;\n"
+
+	t.Run("formats only the statements overlapping the range", func(t *testing.T) {
+		start := strings.Index(query, "from d")
+		edits := formatRange(t, query, start, start+len("from d"), FormatOptionsWithLanguage{})
+		if len(edits) != 1 {
+			t.Fatalf("expected 1 edit, got %v", edits)
+		}
+		expected := "select a from b;\n\n  " + dedent(`
+			select
+			  c
+			from
+			  d
+			where
+			  e = 1;
+		`) + "\nselect f from g;\n"
+		assertEqual(t, apply(query, edits), expected)
+	})
+
+	t.Run("formats every statement the range spans", func(t *testing.T) {
+		start := strings.Index(query, "b;")
+		end := strings.Index(query, "where")
+		edits := formatRange(t, query, start, end, FormatOptionsWithLanguage{})
+		expected := dedent(`
+			select
+			  a
+			from
+			  b;
+
+			select
+			  c
+			from
+			  d
+			where
+			  e = 1;
+		`) + "\nselect f from g;\n"
+		assertEqual(t, apply(query, edits), expected)
+	})
+
+	t.Run("formats the statement at the cursor for an empty range", func(t *testing.T) {
+		cursor := strings.Index(query, "f from")
+		edits := formatRange(t, query, cursor, cursor, FormatOptionsWithLanguage{})
+		if len(edits) != 1 || edits[0].NewText != "select\n  f\nfrom\n  g;" {
+			t.Fatalf("unexpected edits %v", edits)
+		}
+	})
+
+	t.Run("returns no edits outside any statement or for formatted text", func(t *testing.T) {
+		if edits := formatRange(t, query, 17, 18, FormatOptionsWithLanguage{}); len(edits) != 0 {
+			t.Errorf("expected no edits between statements, got %v", edits)
+		}
+		formatted := "select\n  a\nfrom\n  b;\n"
+		if edits := formatRange(t, formatted, 0, len(formatted), FormatOptionsWithLanguage{}); len(edits) != 0 {
+			t.Errorf("expected no edits for formatted text, got %v", edits)
+		}
+	})
+
+	t.Run("numbers positional parameters from the start of the query", func(t *testing.T) {
+		query := "select ?; select ?;"
+		cfg := FormatOptionsWithLanguage{Language: LanguageSqlite, FormatOptions: FormatOptions{Params: []string{"1", "2"}}}
+		edits := formatRange(t, query, len(query)-1, len(query)-1, cfg)
+		if len(edits) != 1 || edits[0].NewText != "select\n  2;" {
+			t.Fatalf("unexpected edits %v", edits)
+		}
+	})
+
+	t.Run("rejects a range outside the query", func(t *testing.T) {
+		if _, err := FormatRange(query, 5, len(query)+1, FormatOptionsWithLanguage{}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package sqlformatter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// streamChunkSize is the minimum number of bytes FormatReader reads at once.
+// It is also the lookahead kept past a statement before it is written, so that
+// a construct spanning the end of the buffer (such as an unterminated block
+// comment) is seen whole before the statements around it are split.
+const streamChunkSize = 64 * 1024
+
+// FormatReader formats the SQL read from r and writes it to w, one statement
+// at a time, so memory use is bounded by the largest statement rather than the
+// whole input. The output is the same as Format on the whole input.
+//
+// Statements are written once the input read so far extends at least 64KiB
+// past their end, and a PL/SQL block is read whole however long it is; another
+// construct that spans a statement boundary further than that may be split
+// differently than by Format. MaxInputBytes limits the whole
+// input, while MaxTokens and MaxNestingDepth apply to each buffered batch of
+// statements.
+func (f *Formatter) FormatReader(ctx context.Context, r io.Reader, w io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	out := bufio.NewWriter(w)
+	params := NewParams(f.cfg.Params)
+	pending := make([]byte, 0, streamChunkSize)
+	// trailing whitespace of the last written statement is held back, as
+	// Format trims it from the end of the output
+	var trailing string
+	wroteAny := false
+	eof := false
+	total := 0
+	// base is the position of pending in the whole input, for parse errors
+	base := Position{Line: 1, Column: 1}
+
+	for !eof {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// read at least as much as is pending, so a long statement is
+		// reparsed a logarithmic number of times
+		want := streamChunkSize
+		if len(pending) > want {
+			want = len(pending)
+		}
+		before := len(pending)
+		var err error
+		pending, err = readAtLeast(r, pending, want)
+		if errors.Is(err, io.EOF) {
+			eof = true
+		} else if err != nil {
+			return err
+		}
+		total += len(pending) - before
+		if max := f.cfg.Limits.MaxInputBytes; max > 0 && total > max {
+			return LimitError{Limit: LimitInputBytes, Max: max}
+		}
+
+		query := string(pending)
+		parser := f.newParser(ctx)
+		statements, err := parser.Parse(query, f.dialect.Tokenizer, f.cfg.ParamTypes)
+		if err != nil {
+			var limitErr LimitError
+			var parseErr ParseError
+			if eof && errors.As(err, &parseErr) {
+				return parseErr.relocate(base)
+			}
+			if eof || errors.As(err, &limitErr) || ctx.Err() != nil {
+				return err
+			}
+			// most likely an unterminated string at the end of the buffer
+			continue
+		}
+
+		count := len(statements)
+		if !eof {
+			// the last statement may be incomplete, and the ones ending
+			// within the lookahead could still change
+			count--
+			for count > 0 && statements[count-1].End.Offset > len(query)-streamChunkSize {
+				count--
+			}
+			// a block still open at the end of the buffer has been parsed
+			// as separate statements, which must wait for its end
+			for count > 0 && parser.unclosedBlock >= 0 && statements[count-1].End.Offset > parser.unclosedBlock {
+				count--
+			}
+		}
+		for i := 0; i < count; i++ {
+			formatted, err := f.formatStatement(ctx, statements[i], params)
+			if err != nil {
+				return err
+			}
+			if wroteAny {
+				if _, err := out.WriteString(trailing + f.statementSeparator(statements[i], formatted)); err != nil {
+					return err
+				}
+			}
+			body := strings.TrimRight(formatted, " \t\n\r")
+			trailing = formatted[len(body):]
+			if _, err := out.WriteString(body); err != nil {
+				return err
+			}
+			wroteAny = true
+		}
+		if count > 0 {
+			end := statements[count-1].End
+			base = advancePosition(base, end)
+			rest := pending[end.Offset:]
+			pending = append(pending[:0:0], rest...)
+		}
+	}
+	return out.Flush()
+}
+
+// advancePosition returns the position in the whole input of pos, a position
+// in a buffered part of the input starting at base.
+func advancePosition(base, pos Position) Position {
+	if pos.Line == 1 {
+		pos.Column += base.Column - 1
+	}
+	pos.Line += base.Line - 1
+	pos.Offset += base.Offset
+	return pos
+}
+
+// relocate moves an error found in a buffered part of the input starting at
+// base to its position in the whole input. The snippet still only shows the
+// buffered part of the line.
+func (e ParseError) relocate(base Position) ParseError {
+	pos := advancePosition(base, Position{Offset: e.Offset, Line: e.Line, Column: e.Column})
+	e.Offset, e.Line, e.Column = pos.Offset, pos.Line, pos.Column
+	return e
+}
+
+// readAtLeast appends at least n bytes from r to buf, or fewer when r is
+// exhausted, in which case io.EOF is returned.
+func readAtLeast(r io.Reader, buf []byte, n int) ([]byte, error) {
+	read := 0
+	for read < n {
+		if len(buf) == cap(buf) {
+			buf = append(buf, 0)[:len(buf)]
+		}
+		m, err := r.Read(buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+m]
+		read += m
+		if err != nil {
+			return buf, err
+		}
+	}
+	return buf, nil
+}
@@ -0,0 +1,113 @@
+package sqlformatter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFormatReader(t *testing.T) {
+	formatReader := func(t *testing.T, cfg FormatOptionsWithLanguage, query string) string {
+		t.Helper()
+		formatter, err := New(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var out strings.Builder
+		if err := formatter.FormatReader(context.Background(), iotest.HalfReader(strings.NewReader(query)), &out); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return out.String()
+	}
+	sameAsFormat := func(t *testing.T, cfg FormatOptionsWithLanguage, query string) {
+		t.Helper()
+		expected, err := Format(query, cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertEqual(t, formatReader(t, cfg, query), expected)
+	}
+
+	t.Run("formats a short query", func(t *testing.T) {
+		result := formatReader(t, FormatOptionsWithLanguage{}, "select a from b; select c from d;")
+		expected := dedent(`
+			select
+			  a
+			from
+			  b;
+
+			select
+			  c
+			from
+			  d;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("matches Format on input larger than a chunk", func(t *testing.T) {
+		var query strings.Builder
+		for i := 0; i < 5000; i++ {
+			fmt.Fprintf(&query, "INSERT INTO t (id, name) VALUES (%d, 'row; %d'); -- row %d\n", i, i, i)
+			if i%100 == 0 {
+				fmt.Fprintf(&query, "CREATE FUNCTION f%d() RETURNS int AS $$ SELECT 1; SELECT %d; $$ LANGUAGE sql;\n/* block; comment */\n", i, i)
+			}
+		}
+		sameAsFormat(t, FormatOptionsWithLanguage{FormatOptions: FormatOptions{LinesBetweenQueries: 2, LinesBetweenQueriesSet: true}}, query.String())
+	})
+
+	t.Run("numbers positional params across statements", func(t *testing.T) {
+		query := strings.Repeat("SELECT ?, ?;\n", 8000)
+		params := make([]string, 16000)
+		for i := range params {
+			params[i] = fmt.Sprint(i)
+		}
+		sameAsFormat(t, FormatOptionsWithLanguage{Language: LanguageSqlite, FormatOptions: FormatOptions{Params: params}}, query)
+	})
+
+	t.Run("keeps PL/SQL blocks together", func(t *testing.T) {
+		block := "BEGIN\n" + strings.Repeat("  UPDATE t SET a = a + 1 WHERE id = 1;\n", 3000) + "END;\n/\n"
+		sameAsFormat(t, FormatOptionsWithLanguage{Language: LanguagePlsql}, "SELECT 1 FROM dual;\n"+block+"SELECT 2 FROM dual;")
+	})
+
+	t.Run("reads PL/SQL blocks longer than a chunk whole", func(t *testing.T) {
+		var query strings.Builder
+		query.WriteString(strings.Repeat("SELECT 1 FROM dual;\n", 5000))
+		query.WriteString("CREATE OR REPLACE PACKAGE BODY pk AS\n")
+		for i := 0; i < 2000; i++ {
+			fmt.Fprintf(&query, "  PROCEDURE p%d IS\n  BEGIN\n    UPDATE t SET a = a + 1 WHERE id = %d;\n  END;\n", i, i)
+		}
+		query.WriteString("END pk;\n/\nSELECT 2 FROM dual;\n")
+		if query.Len() < 3*streamChunkSize {
+			t.Fatalf("expected the package body to span several chunks, got %d bytes", query.Len())
+		}
+		sameAsFormat(t, FormatOptionsWithLanguage{Language: LanguagePlsql}, query.String())
+	})
+
+	t.Run("reports parse errors", func(t *testing.T) {
+		formatter, err := New(FormatOptionsWithLanguage{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var out strings.Builder
+		if err := formatter.FormatReader(context.Background(), strings.NewReader("SELECT 'unterminated"), &out); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		formatter, err := New(FormatOptionsWithLanguage{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var out strings.Builder
+		err = formatter.FormatReader(ctx, strings.NewReader("SELECT 1;"), &out)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+}
@@ -1,57 +1,139 @@
 package sqlformatter
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"strings"
+)
 
+// Formatter formats queries with a fixed dialect and configuration. A
+// Formatter is immutable once created, so a single Formatter may be shared
+// between goroutines and used for concurrent Format calls.
 type Formatter struct {
 	dialect *Dialect
 	cfg     FormatOptions
-	params  *Params
 }
 
+// New returns a Formatter for the language and options in cfg. The options are
+// merged with the defaults and validated once, so repeated Format calls skip
+// that work.
+func New(cfg FormatOptionsWithLanguage) (*Formatter, error) {
+	if cfg.Language == "" {
+		cfg.Language = LanguagePostgresql
+	}
+	if _, ok := dialectNameMap[cfg.Language]; !ok {
+		return nil, ConfigError{Message: fmt.Sprintf("Unsupported SQL dialect: %s", cfg.Language)}
+	}
+	return NewWithDialect(languageDialect(cfg.Language), cfg.FormatOptions)
+}
+
+// NewWithDialect is like New for a dialect created by NewDialect or
+// CreateDialect.
+func NewWithDialect(dialect *Dialect, cfg FormatOptions) (*Formatter, error) {
+	validated, err := validateConfig(mergeOptions(defaultOptions, cfg))
+	if err != nil {
+		return nil, err
+	}
+	validated.Params = copyParams(validated.Params)
+	if validated.ParamTypes != nil {
+		paramTypes := *validated.ParamTypes
+		validated.ParamTypes = &paramTypes
+	}
+	return &Formatter{dialect: dialect, cfg: validated}, nil
+}
+
+// NewFormatter returns a Formatter without merging cfg with the defaults or
+// validating it. Prefer New.
 func NewFormatter(dialect *Dialect, cfg FormatOptions) *Formatter {
-	return &Formatter{dialect: dialect, cfg: cfg, params: NewParams(cfg.Params)}
+	return &Formatter{dialect: dialect, cfg: cfg}
 }
 
 func (f *Formatter) Format(query string) (string, error) {
-	parser := NewParser(f.dialect.Tokenizer)
+	return f.FormatContext(context.Background(), query)
+}
+
+// FormatContext is like Format but stops with ctx.Err() once ctx is done, and
+// enforces the configured Limits.
+func (f *Formatter) FormatContext(ctx context.Context, query string) (string, error) {
+	parser := f.newParser(ctx)
 	ast, err := parser.Parse(query, f.dialect.Tokenizer, f.cfg.ParamTypes)
 	if err != nil {
 		return "", err
 	}
-	formatted := f.formatAst(ast)
+	// Positional parameters are numbered across the whole query, so each
+	// call gets its own Params.
+	formatted, err := f.formatAst(ctx, ast, NewParams(f.cfg.Params))
+	if err != nil {
+		return "", err
+	}
 	return strings.TrimRight(formatted, " \t\n\r"), nil
 }
 
-func (f *Formatter) formatAst(statements []*StatementNode) string {
+func (f *Formatter) newParser(ctx context.Context) *Parser {
+	parser := NewParser(f.dialect.Tokenizer)
+	parser.ctx = ctx
+	parser.limits = f.cfg.Limits
+	return parser
+}
+
+func (f *Formatter) formatAst(ctx context.Context, statements []*StatementNode, params *Params) (string, error) {
 	parts := make([]string, 0, len(statements))
 	for _, stmt := range statements {
-		parts = append(parts, f.formatStatement(stmt))
+		part, err := f.formatStatement(ctx, stmt, params)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
 	}
 	if len(parts) == 0 {
-		return ""
+		return "", nil
 	}
 	var out strings.Builder
 	out.WriteString(parts[0])
 	for i := 1; i < len(parts); i++ {
-		if startsWithLineComment(parts[i]) {
-			out.WriteString("\n")
-		} else {
-			out.WriteString(strings.Repeat("\n", f.cfg.LinesBetweenQueries+1))
-		}
+		out.WriteString(f.statementSeparator(statements[i], parts[i]))
 		out.WriteString(parts[i])
 	}
-	return out.String()
+	return out.String(), nil
+}
+
+// statementSeparator returns the newlines written before a formatted
+// statement that follows another one.
+func (f *Formatter) statementSeparator(statement *StatementNode, formatted string) string {
+	if statement.BatchSeparator || startsWithLineComment(formatted) {
+		return "\n"
+	}
+	return strings.Repeat("\n", f.cfg.LinesBetweenQueries+1)
 }
 
 func startsWithLineComment(formatted string) bool {
 	return strings.HasPrefix(strings.TrimLeft(formatted, " \t"), "--")
 }
 
-func (f *Formatter) formatStatement(statement *StatementNode) string {
+// formatAbort is panicked with by the expression formatter to unwind out of
+// a statement when formatting is cancelled.
+type formatAbort struct {
+	err error
+}
+
+func (f *Formatter) formatStatement(ctx context.Context, statement *StatementNode, params *Params) (formatted string, err error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			abort, ok := r.(formatAbort)
+			if !ok {
+				panic(r)
+			}
+			err = abort.err
+		}
+	}()
 	layout := NewExpressionFormatter(ExpressionFormatterParams{
+		Ctx:        ctx,
 		Cfg:        f.cfg,
 		DialectCfg: f.dialect.FormatOptions,
-		Params:     f.params,
+		Params:     params,
 		Layout:     NewLayout(NewIndentation(indentString(f.cfg))),
 	}).Format(statement.Children)
 
@@ -61,6 +143,8 @@ func (f *Formatter) formatStatement(statement *StatementNode) string {
 		} else {
 			layout.Add(NoNewline, ";")
 		}
+	} else {
+		layout.Add(NoNewline)
 	}
-	return layout.ToString()
+	return layout.ToString(), nil
 }
@@ -0,0 +1,87 @@
+package sqlformatter
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("formats with the configured language and options", func(t *testing.T) {
+		formatter, err := New(FormatOptionsWithLanguage{Language: LanguageMysql, FormatOptions: FormatOptions{KeywordCase: KeywordCaseUpper}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result, err := formatter.Format("select `a` from t")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			SELECT
+			  ` + "`a`" + `
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("validates the configuration once", func(t *testing.T) {
+		_, err := New(FormatOptionsWithLanguage{Language: "nosuchsql"})
+		var configErr ConfigError
+		if !errors.As(err, &configErr) {
+			t.Fatalf("expected ConfigError for unknown language, got %v", err)
+		}
+		_, err = New(FormatOptionsWithLanguage{FormatOptions: FormatOptions{ExpressionWidth: -1, ExpressionWidthSet: true}})
+		if !errors.As(err, &configErr) {
+			t.Fatalf("expected ConfigError for invalid options, got %v", err)
+		}
+	})
+
+	t.Run("numbers positional params per call", func(t *testing.T) {
+		params := []string{"first", "second"}
+		formatter, err := New(FormatOptionsWithLanguage{Language: LanguageSqlite, FormatOptions: FormatOptions{Params: params}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		params[0] = "changed"
+		for i := 0; i < 2; i++ {
+			result, err := formatter.Format("SELECT ?, ?;")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			expected := dedent(`
+				SELECT
+				  first,
+				  second;
+			`)
+			assertEqual(t, result, expected)
+		}
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		formatter, err := New(FormatOptionsWithLanguage{Language: LanguageSqlite, FormatOptions: FormatOptions{Params: []string{"1", "2"}}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		query := "SELECT ?, ? FROM t WHERE a = 1 AND b IN (SELECT c FROM d)"
+		expected, err := formatter.Format(query)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					result, err := formatter.Format(query)
+					if err != nil || result != expected {
+						t.Errorf("unexpected result %q (%v)", result, err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
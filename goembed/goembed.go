@@ -0,0 +1,179 @@
+// Package goembed formats the SQL embedded in Go source files.
+package goembed
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	sqlformatter "sql-formatter-go"
+)
+
+// Format formats the SQL in the raw string literals of the Go source src
+// that are passed as the query of a database/sql, sqlx, pgx or pgxpool
+// function or method, such as db.QueryContext, or preceded by a /* sql */
+// comment. Each formatted query is put on its own lines between the
+// backquotes, indented one tab deeper than the line the literal starts on:
+//
+//	rows, err := db.QueryContext(ctx, `
+//		SELECT
+//		  id
+//		FROM
+//		  users
+//	`)
+//
+// The receivers of query methods are type checked within src alone, so a
+// database handle declared in another file of the package is only recognized
+// through a /* sql */ comment. Literals that do not parse as SQL are left
+// unchanged. Syntax errors in src are returned as a scanner.ErrorList.
+func Format(src []byte, formatter *sqlformatter.Formatter) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	tokenFile := fset.File(file.Pos())
+
+	// offsets of the literals that follow a /* sql */ comment
+	marked := map[int]bool{}
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if isMarker(comment.Text) {
+				end := tokenFile.Offset(comment.End())
+				marked[end+len(src[end:])-len(bytes.TrimLeft(src[end:], " \t\r\n"))] = true
+			}
+		}
+	}
+
+	// the file is checked on its own, so errors about the rest of its
+	// package and about other imports are expected and ignored
+	importer := newStubImporter(fset)
+	info := &types.Info{Uses: map[*ast.Ident]types.Object{}}
+	config := &types.Config{Importer: importer, Error: func(error) {}}
+	_, _ = config.Check(file.Name.Name, fset, []*ast.File{file}, info)
+
+	seen := map[int]bool{}
+	var literals []*ast.BasicLit
+	add := func(lit *ast.BasicLit) {
+		if offset := tokenFile.Offset(lit.Pos()); !seen[offset] {
+			seen[offset] = true
+			literals = append(literals, lit)
+		}
+	}
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.BasicLit:
+			if isRawString(node) && marked[tokenFile.Offset(node.Pos())] {
+				add(node)
+			}
+		case *ast.CallExpr:
+			selector, ok := node.Fun.(*ast.SelectorExpr)
+			if !ok {
+				break
+			}
+			fn, ok := info.Uses[selector.Sel].(*types.Func)
+			if !ok || !importer.isStub(fn.Pkg()) {
+				break
+			}
+			index := queryParam(fn)
+			if index < 0 || index >= len(node.Args) {
+				break
+			}
+			if lit, ok := node.Args[index].(*ast.BasicLit); ok && isRawString(lit) {
+				add(lit)
+			}
+		}
+		return true
+	})
+	sort.Slice(literals, func(i, j int) bool { return literals[i].Pos() < literals[j].Pos() })
+
+	var out bytes.Buffer
+	last := 0
+	for _, lit := range literals {
+		start := tokenFile.Offset(lit.Pos())
+		formatted, err := formatLiteral(src, start, lit.Value, formatter)
+		if err != nil {
+			return nil, err
+		}
+		out.Write(src[last:start])
+		out.WriteString(formatted)
+		last = start + len(lit.Value)
+	}
+	out.Write(src[last:])
+	return out.Bytes(), nil
+}
+
+// queryParam returns the index of the query parameter of fn, or -1.
+func queryParam(fn *types.Func) int {
+	params := fn.Type().(*types.Signature).Params()
+	for i := 0; i < params.Len(); i++ {
+		if params.At(i).Name() == "query" {
+			return i
+		}
+	}
+	return -1
+}
+
+func isMarker(comment string) bool {
+	if !strings.HasPrefix(comment, "/*") {
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(comment[2:len(comment)-2]), "sql")
+}
+
+func isRawString(lit *ast.BasicLit) bool {
+	return lit.Kind == token.STRING && strings.HasPrefix(lit.Value, "`")
+}
+
+// formatLiteral formats the SQL in the raw string literal lit found at start
+// in src.
+func formatLiteral(src []byte, start int, lit string, formatter *sqlformatter.Formatter) (string, error) {
+	query := lit[1 : len(lit)-1]
+	if strings.TrimSpace(query) == "" {
+		return lit, nil
+	}
+	formatted, err := formatter.Format(query)
+	if err != nil {
+		var parseErr sqlformatter.ParseError
+		if errors.As(err, &parseErr) {
+			// most likely not SQL, or SQL this dialect does not know
+			return lit, nil
+		}
+		return "", err
+	}
+	formatted = strings.TrimRight(formatted, " \t\n")
+
+	lineStart := bytes.LastIndexByte(src[:start], '\n') + 1
+	line := src[lineStart:start]
+	indent := string(line[:len(line)-len(bytes.TrimLeft(line, " \t"))])
+	// indenting changes the value of multiline strings in the SQL, in which
+	// case the query is left at the start of the lines
+	body := formatted
+	if indented := indentLines(formatted, indent+"\t"); formatAgain(formatter, indented) == formatted {
+		body = indented
+	}
+	return "`\n" + body + "\n" + indent + "`", nil
+}
+
+func formatAgain(formatter *sqlformatter.Formatter, query string) string {
+	formatted, err := formatter.Format(query)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(formatted, " \t\n")
+}
+
+func indentLines(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
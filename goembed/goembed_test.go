@@ -0,0 +1,252 @@
+package goembed
+
+import (
+	"errors"
+	"go/scanner"
+	"go/token"
+	"strings"
+	"testing"
+
+	sqlformatter "sql-formatter-go"
+)
+
+func newFormatter(t *testing.T) *sqlformatter.Formatter {
+	t.Helper()
+	formatter, err := sqlformatter.New(sqlformatter.FormatOptionsWithLanguage{Language: sqlformatter.LanguagePostgresql})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return formatter
+}
+
+func format(t *testing.T, src string) string {
+	t.Helper()
+	out, err := Format([]byte(src), newFormatter(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(out)
+}
+
+func lines(lines ...string) string {
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestFormat(t *testing.T) {
+	t.Run("formats raw strings passed to query methods", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"import \"database/sql\"",
+			"",
+			"func find(ctx context.Context, db *sql.DB, id int) {",
+			"\trows, err := db.QueryContext(ctx, `select id, name from users where id = $1`, id)",
+			"\ttx, err := db.BeginTx(ctx, nil)",
+			"\ttx.Exec(`delete from users`)",
+			"}",
+		)
+		expected := lines(
+			"package db",
+			"",
+			"import \"database/sql\"",
+			"",
+			"func find(ctx context.Context, db *sql.DB, id int) {",
+			"\trows, err := db.QueryContext(ctx, `",
+			"\t\tselect",
+			"\t\t  id,",
+			"\t\t  name",
+			"\t\tfrom",
+			"\t\t  users",
+			"\t\twhere",
+			"\t\t  id = $1",
+			"\t`, id)",
+			"\ttx, err := db.BeginTx(ctx, nil)",
+			"\ttx.Exec(`",
+			"\t\tdelete from users",
+			"\t`)",
+			"}",
+		)
+		out := format(t, src)
+		if out != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, out)
+		}
+		if again := format(t, out); again != out {
+			t.Errorf("expected formatting to be idempotent, got\n%s", again)
+		}
+	})
+
+	t.Run("formats raw strings marked with a sql comment", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"var (",
+			"\tcount = /* sql */ `select count(*) from users`",
+			"\tname  = `select name from users`",
+			")",
+		)
+		expected := lines(
+			"package db",
+			"",
+			"var (",
+			"\tcount = /* sql */ `",
+			"\t\tselect",
+			"\t\t  count(*)",
+			"\t\tfrom",
+			"\t\t  users",
+			"\t`",
+			"\tname  = `select name from users`",
+			")",
+		)
+		if out := format(t, src); out != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, out)
+		}
+	})
+
+	t.Run("finds the query argument of sqlx and pgx methods", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"import (",
+			"\t\"github.com/jackc/pgx/v5/pgxpool\"",
+			"\t\"github.com/jmoiron/sqlx\"",
+			")",
+			"",
+			"type store struct {",
+			"\tdb   *sqlx.DB",
+			"\tpool *pgxpool.Pool",
+			"}",
+			"",
+			"func (s *store) f(ctx context.Context) {",
+			"\ts.db.GetContext(ctx, `dest`, `select 1`)",
+			"\ts.db.QueryRowContext(ctx, `select 2`)",
+			"\tconn, _ := s.pool.Acquire(ctx)",
+			"\tconn.Conn().Prepare(ctx, `name`, `select 3`)",
+			"\ts.pool.Query(ctx, `select 4`)",
+			"}",
+		)
+		expected := lines(
+			"package db",
+			"",
+			"import (",
+			"\t\"github.com/jackc/pgx/v5/pgxpool\"",
+			"\t\"github.com/jmoiron/sqlx\"",
+			")",
+			"",
+			"type store struct {",
+			"\tdb   *sqlx.DB",
+			"\tpool *pgxpool.Pool",
+			"}",
+			"",
+			"func (s *store) f(ctx context.Context) {",
+			"\ts.db.GetContext(ctx, `dest`, `",
+			"\t\tselect",
+			"\t\t  1",
+			"\t`)",
+			"\ts.db.QueryRowContext(ctx, `",
+			"\t\tselect",
+			"\t\t  2",
+			"\t`)",
+			"\tconn, _ := s.pool.Acquire(ctx)",
+			"\tconn.Conn().Prepare(ctx, `name`, `",
+			"\t\tselect",
+			"\t\t  3",
+			"\t`)",
+			"\ts.pool.Query(ctx, `",
+			"\t\tselect",
+			"\t\t  4",
+			"\t`)",
+			"}",
+		)
+		if out := format(t, src); out != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, out)
+		}
+	})
+
+	t.Run("leaves strings passed to other methods alone", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"import \"database/sql\"",
+			"",
+			"func f(db *sql.DB, cache *lru.Cache, r chi.Router) {",
+			"\tcache.Get(`user 42`)",
+			"\tr.Get(`/users/{id}`, h)",
+			"\tdb.Exec(\"select 1\")",
+			"\tfmt.Println(`select 1`)",
+			"\tdb.Exec(`  `)",
+			"}",
+		)
+		if out := format(t, src); out != src {
+			t.Errorf("expected no changes, got\n%s", out)
+		}
+	})
+
+	t.Run("leaves strings that are not SQL alone", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"var (",
+			"\troute = /* sql */ `/users/{id}`",
+			"\tquery = /* sql */ `select 1`",
+			")",
+		)
+		expected := lines(
+			"package db",
+			"",
+			"var (",
+			"\troute = /* sql */ `/users/{id}`",
+			"\tquery = /* sql */ `",
+			"\t\tselect",
+			"\t\t  1",
+			"\t`",
+			")",
+		)
+		if out := format(t, src); out != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, out)
+		}
+	})
+
+	t.Run("does not indent queries with multiline strings", func(t *testing.T) {
+		src := lines(
+			"package db",
+			"",
+			"func f() {",
+			"\tq := /* sql */ `insert into notes values ('a",
+			"b')`",
+			"}",
+		)
+		expected := lines(
+			"package db",
+			"",
+			"func f() {",
+			"\tq := /* sql */ `",
+			"insert into",
+			"  notes",
+			"values",
+			"  ('a",
+			"b')",
+			"\t`",
+			"}",
+		)
+		if out := format(t, src); out != expected {
+			t.Errorf("expected\n%s\ngot\n%s", expected, out)
+		}
+	})
+
+	t.Run("returns Go syntax errors", func(t *testing.T) {
+		_, err := Format([]byte("package db\n\nfunc f( {\n"), newFormatter(t))
+		var syntaxErr scanner.ErrorList
+		if !errors.As(err, &syntaxErr) {
+			t.Fatalf("expected a scanner.ErrorList, got %v", err)
+		}
+	})
+}
+
+func TestStubs(t *testing.T) {
+	importer := newStubImporter(token.NewFileSet())
+	for path := range importer.sources {
+		if _, err := importer.Import(path); err != nil {
+			t.Errorf("stub %s: %v", path, err)
+		}
+	}
+}
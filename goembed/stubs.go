@@ -0,0 +1,223 @@
+package goembed
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// The stubs declare just enough of the database/sql, sqlx, pgx and pgxpool
+// APIs to type check the receivers of query methods without loading the real
+// packages. Every function or method taking SQL names that parameter query.
+
+const sqlStub = `package sql
+
+type DB struct{}
+type Tx struct{}
+type Conn struct{}
+type Stmt struct{}
+type Rows struct{}
+type Row struct{}
+type Result interface{}
+type TxOptions struct{}
+
+func Open(driverName, dataSourceName string) (*DB, error)
+func OpenDB(c any) *DB
+
+func (*DB) Begin() (*Tx, error)
+func (*DB) BeginTx(ctx any, opts *TxOptions) (*Tx, error)
+func (*DB) Conn(ctx any) (*Conn, error)
+func (*Conn) BeginTx(ctx any, opts *TxOptions) (*Tx, error)
+` + "{{methods DB}}{{contextMethods DB}}{{methods Tx}}{{contextMethods Tx}}{{contextMethods Conn}}"
+
+const sqlMethods = `
+func (*{{type}}) Exec(query string, args ...any) (Result, error)
+func (*{{type}}) Query(query string, args ...any) (*Rows, error)
+func (*{{type}}) QueryRow(query string, args ...any) *Row
+func (*{{type}}) Prepare(query string) (*Stmt, error)
+`
+
+const sqlContextMethods = `
+func (*{{type}}) ExecContext(ctx any, query string, args ...any) (Result, error)
+func (*{{type}}) QueryContext(ctx any, query string, args ...any) (*Rows, error)
+func (*{{type}}) QueryRowContext(ctx any, query string, args ...any) *Row
+func (*{{type}}) PrepareContext(ctx any, query string) (*Stmt, error)
+`
+
+const sqlxStub = `package sqlx
+
+import "database/sql"
+
+type DB struct{ *sql.DB }
+type Tx struct{ *sql.Tx }
+type Conn struct{ *sql.Conn }
+type Stmt struct{}
+type NamedStmt struct{}
+type Rows struct{}
+type Row struct{}
+
+func Open(driverName, dataSourceName string) (*DB, error)
+func MustOpen(driverName, dataSourceName string) *DB
+func Connect(driverName, dataSourceName string) (*DB, error)
+func ConnectContext(ctx any, driverName, dataSourceName string) (*DB, error)
+func MustConnect(driverName, dataSourceName string) *DB
+func NewDb(db *sql.DB, driverName string) *DB
+
+func (*DB) Beginx() (*Tx, error)
+func (*DB) BeginTxx(ctx any, opts *sql.TxOptions) (*Tx, error)
+func (*DB) MustBegin() *Tx
+func (*DB) MustBeginTx(ctx any, opts *sql.TxOptions) *Tx
+func (*DB) Connx(ctx any) (*Conn, error)
+func (*Conn) BeginTxx(ctx any, opts *sql.TxOptions) (*Tx, error)
+
+func In(query string, args ...any) (string, []any, error)
+func Named(query string, arg any) (string, []any, error)
+func Get(q any, dest any, query string, args ...any) error
+func GetContext(ctx any, q any, dest any, query string, args ...any) error
+func Select(q any, dest any, query string, args ...any) error
+func SelectContext(ctx any, q any, dest any, query string, args ...any) error
+func MustExec(e any, query string, args ...any) sql.Result
+func MustExecContext(ctx any, e any, query string, args ...any) sql.Result
+func NamedExec(e any, query string, arg any) (sql.Result, error)
+func NamedExecContext(ctx any, e any, query string, arg any) (sql.Result, error)
+func NamedQuery(e any, query string, arg any) (*Rows, error)
+func NamedQueryContext(ctx any, e any, query string, arg any) (*Rows, error)
+` + "{{methods DB}}{{contextMethods DB}}{{methods Tx}}{{contextMethods Tx}}{{contextMethods Conn}}"
+
+const sqlxMethods = `
+func (*{{type}}) Get(dest any, query string, args ...any) error
+func (*{{type}}) Select(dest any, query string, args ...any) error
+func (*{{type}}) MustExec(query string, args ...any) sql.Result
+func (*{{type}}) NamedExec(query string, arg any) (sql.Result, error)
+func (*{{type}}) NamedQuery(query string, arg any) (*Rows, error)
+func (*{{type}}) Queryx(query string, args ...any) (*Rows, error)
+func (*{{type}}) QueryRowx(query string, args ...any) *Row
+func (*{{type}}) Preparex(query string) (*Stmt, error)
+func (*{{type}}) PrepareNamed(query string) (*NamedStmt, error)
+func (*{{type}}) Rebind(query string) string
+`
+
+const sqlxContextMethods = `
+func (*{{type}}) GetContext(ctx any, dest any, query string, args ...any) error
+func (*{{type}}) SelectContext(ctx any, dest any, query string, args ...any) error
+func (*{{type}}) MustExecContext(ctx any, query string, args ...any) sql.Result
+func (*{{type}}) NamedExecContext(ctx any, query string, arg any) (sql.Result, error)
+func (*{{type}}) QueryxContext(ctx any, query string, args ...any) (*Rows, error)
+func (*{{type}}) QueryRowxContext(ctx any, query string, args ...any) *Row
+func (*{{type}}) PreparexContext(ctx any, query string) (*Stmt, error)
+func (*{{type}}) PrepareNamedContext(ctx any, query string) (*NamedStmt, error)
+`
+
+const pgxStub = `package pgx
+
+type Conn struct{}
+type ConnConfig struct{}
+type TxOptions struct{}
+type Rows interface{}
+type Row interface{}
+type Batch struct{}
+
+type Tx interface {
+	Begin(ctx any) (Tx, error)
+	Exec(ctx any, query string, args ...any) (any, error)
+	Query(ctx any, query string, args ...any) (Rows, error)
+	QueryRow(ctx any, query string, args ...any) Row
+	Prepare(ctx any, name, query string) (any, error)
+}
+
+func Connect(ctx any, connString string) (*Conn, error)
+func ConnectConfig(ctx any, connConfig *ConnConfig) (*Conn, error)
+
+func (*Conn) Begin(ctx any) (Tx, error)
+func (*Conn) BeginTx(ctx any, txOptions TxOptions) (Tx, error)
+func (*Conn) Prepare(ctx any, name, query string) (any, error)
+func (*Batch) Queue(query string, args ...any) any
+` + "{{methods Conn}}"
+
+const pgxpoolStub = `package pgxpool
+
+import "{{pgx}}"
+
+type Pool struct{}
+type Conn struct{}
+type Config struct{}
+
+func New(ctx any, connString string) (*Pool, error)
+func NewWithConfig(ctx any, config *Config) (*Pool, error)
+func Connect(ctx any, connString string) (*Pool, error)
+func ConnectConfig(ctx any, config *Config) (*Pool, error)
+
+func (*Pool) Acquire(ctx any) (*Conn, error)
+func (*Pool) Begin(ctx any) (pgx.Tx, error)
+func (*Pool) BeginTx(ctx any, txOptions pgx.TxOptions) (pgx.Tx, error)
+func (*Conn) Begin(ctx any) (pgx.Tx, error)
+func (*Conn) BeginTx(ctx any, txOptions pgx.TxOptions) (pgx.Tx, error)
+func (*Conn) Conn() *pgx.Conn
+` + "{{methods Pool}}{{methods Conn}}"
+
+const pgxMethods = `
+func (*{{type}}) Exec(ctx any, query string, args ...any) (any, error)
+func (*{{type}}) Query(ctx any, query string, args ...any) ({{pgxPrefix}}Rows, error)
+func (*{{type}}) QueryRow(ctx any, query string, args ...any) {{pgxPrefix}}Row
+`
+
+// stubSources returns the stub source of each package path.
+func stubSources() map[string]string {
+	expand := func(src, methods, contextMethods string) string {
+		for _, typeName := range []string{"DB", "Tx", "Conn", "Pool"} {
+			src = strings.ReplaceAll(src, "{{methods "+typeName+"}}", strings.ReplaceAll(methods, "{{type}}", typeName))
+			src = strings.ReplaceAll(src, "{{contextMethods "+typeName+"}}", strings.ReplaceAll(contextMethods, "{{type}}", typeName))
+		}
+		return src
+	}
+	sources := map[string]string{
+		"database/sql":            expand(sqlStub, sqlMethods, sqlContextMethods),
+		"github.com/jmoiron/sqlx": expand(sqlxStub, sqlxMethods, sqlxContextMethods),
+	}
+	for _, major := range []string{"v4", "v5"} {
+		pgxPath := "github.com/jackc/pgx/" + major
+		sources[pgxPath] = strings.ReplaceAll(expand(pgxStub, pgxMethods, ""), "{{pgxPrefix}}", "")
+		pool := strings.ReplaceAll(expand(pgxpoolStub, pgxMethods, ""), "{{pgxPrefix}}", "pgx.")
+		sources[pgxPath+"/pgxpool"] = strings.ReplaceAll(pool, "{{pgx}}", pgxPath)
+	}
+	return sources
+}
+
+// stubImporter imports the stub packages, and fails for any other package.
+type stubImporter struct {
+	fset     *token.FileSet
+	sources  map[string]string
+	packages map[string]*types.Package
+}
+
+func newStubImporter(fset *token.FileSet) *stubImporter {
+	return &stubImporter{fset: fset, sources: stubSources(), packages: map[string]*types.Package{}}
+}
+
+func (imp *stubImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := imp.packages[path]; ok {
+		return pkg, nil
+	}
+	src, ok := imp.sources[path]
+	if !ok {
+		return nil, fmt.Errorf("goembed: package %s is not a database package", path)
+	}
+	file, err := parser.ParseFile(imp.fset, path+"/stub.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := (&types.Config{Importer: imp}).Check(path, imp.fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, err
+	}
+	imp.packages[path] = pkg
+	return pkg, nil
+}
+
+// isStub reports whether pkg is one of the stub packages.
+func (imp *stubImporter) isStub(pkg *types.Package) bool {
+	return pkg != nil && imp.packages[pkg.Path()] == pkg
+}
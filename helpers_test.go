@@ -28,6 +28,26 @@ func formatPostgresErr(t *testing.T, query string, opts ...FormatOptions) error
 	return err
 }
 
+func formatLanguage(t *testing.T, language SqlLanguage, query string, opts ...FormatOptions) string {
+	t.Helper()
+	cfg := FormatOptionsWithLanguage{Language: language}
+	if len(opts) > 0 {
+		cfg.FormatOptions = opts[0]
+	}
+	out, err := Format(query, cfg)
+	require.NoError(t, err)
+	return out
+}
+
+func formatLanguageErr(language SqlLanguage, query string, opts ...FormatOptions) error {
+	cfg := FormatOptionsWithLanguage{Language: language}
+	if len(opts) > 0 {
+		cfg.FormatOptions = opts[0]
+	}
+	_, err := Format(query, cfg)
+	return err
+}
+
 func dedent(text string) string {
 	return testutil.Dedent(text)
 }
@@ -22,24 +22,27 @@ func (m *IdentifierMatcher) Match(input string, index int) (string, bool) {
 		return "", false
 	}
 	pos := index + size
-	lastWasDash := false
 	for pos < len(input) {
 		r2, size2 := utf8DecodeRuneInString(input[pos:])
 		if r2 == '-' && m.chars.Dashes {
-			lastWasDash = true
+			// a dash only continues the identifier when another identifier
+			// character follows, so "a--b" stays "a" followed by a comment
+			// and "a-" stays "a" followed by an operator.
+			if pos+size2 >= len(input) {
+				break
+			}
+			r3, _ := utf8DecodeRuneInString(input[pos+size2:])
+			if !m.isRestChar(r3) {
+				break
+			}
 			pos += size2
 			continue
 		}
 		if !m.isRestChar(r2) {
 			break
 		}
-		lastWasDash = false
 		pos += size2
 	}
-	if lastWasDash {
-		// identifiers cannot end with dash
-		return "", false
-	}
 	return input[index:pos], true
 }
 
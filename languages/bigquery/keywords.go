@@ -0,0 +1,442 @@
+package bigquery
+
+var Keywords = []string{
+	"ACCESS",
+	"ADD",
+	"AGGREGATE",
+	"ALL",
+	"ALTER",
+	"AND",
+	"ANY",
+	"AS",
+	"ASC",
+	"ASSERT",
+	"ASSERT_ROWS_MODIFIED",
+	"AT",
+	"BEGIN",
+	"BETWEEN",
+	"BREAK",
+	"BY",
+	"CALL",
+	"CASCADE",
+	"CASE",
+	"CHECK",
+	"CLUSTER",
+	"COLLATE",
+	"COLUMN",
+	"COLUMNS",
+	"COMMIT",
+	"CONNECTION",
+	"CONSTRAINT",
+	"CONTAINS",
+	"CONTINUE",
+	"CREATE",
+	"CROSS",
+	"CUBE",
+	"CURRENT",
+	"DATA",
+	"DECLARE",
+	"DEFAULT",
+	"DEFINE",
+	"DELETE",
+	"DESC",
+	"DETERMINISTIC",
+	"DISTINCT",
+	"DO",
+	"DROP",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"ENFORCED",
+	"ENUM",
+	"ESCAPE",
+	"EXCEPT",
+	"EXCLUDE",
+	"EXECUTE",
+	"EXISTS",
+	"EXPORT",
+	"EXTERNAL",
+	"FALSE",
+	"FETCH",
+	"FILTER",
+	"FOLLOWING",
+	"FOR",
+	"FOREIGN",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GRANT",
+	"GROUP",
+	"GROUPING",
+	"GROUPS",
+	"HASH",
+	"HAVING",
+	"IF",
+	"IGNORE",
+	"IMMEDIATE",
+	"IN",
+	"INNER",
+	"INSERT",
+	"INTERSECT",
+	"INTERVAL",
+	"INTO",
+	"IS",
+	"ITERATE",
+	"JOIN",
+	"KEY",
+	"LANGUAGE",
+	"LATERAL",
+	"LEAVE",
+	"LEFT",
+	"LIKE",
+	"LIMIT",
+	"LOOKUP",
+	"LOOP",
+	"MATCHED",
+	"MATERIALIZED",
+	"MERGE",
+	"MODEL",
+	"NATURAL",
+	"NEW",
+	"NO",
+	"NOT",
+	"NULL",
+	"NULLS",
+	"OF",
+	"ON",
+	"OPTIONS",
+	"OR",
+	"ORDER",
+	"OUTER",
+	"OVER",
+	"PARTITION",
+	"PIVOT",
+	"POLICY",
+	"PRECEDING",
+	"PRIMARY",
+	"PROCEDURE",
+	"PROTO",
+	"QUALIFY",
+	"RAISE",
+	"RANGE",
+	"RECURSIVE",
+	"REFERENCES",
+	"RENAME",
+	"REPEAT",
+	"REPLACE",
+	"RESPECT",
+	"RESTRICT",
+	"RETURN",
+	"RETURNS",
+	"REVOKE",
+	"RIGHT",
+	"ROLLBACK",
+	"ROLLUP",
+	"ROW",
+	"ROWS",
+	"SCHEMA",
+	"SELECT",
+	"SET",
+	"SNAPSHOT",
+	"SOME",
+	"SOURCE",
+	"SYSTEM",
+	"SYSTEM_TIME",
+	"TABLE",
+	"TABLESAMPLE",
+	"TARGET",
+	"TEMP",
+	"TEMPORARY",
+	"THEN",
+	"TO",
+	"TRANSACTION",
+	"TREAT",
+	"TRUE",
+	"TRUNCATE",
+	"UNBOUNDED",
+	"UNION",
+	"UNNEST",
+	"UNPIVOT",
+	"UNTIL",
+	"UPDATE",
+	"USING",
+	"VALUE",
+	"VALUES",
+	"VIEW",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WINDOW",
+	"WITH",
+	"WITHIN",
+	"ZONE",
+}
+
+var DataTypes = []string{
+	"ARRAY",
+	"BIGDECIMAL",
+	"BIGINT",
+	"BIGNUMERIC",
+	"BOOL",
+	"BOOLEAN",
+	"BYTEINT",
+	"BYTES",
+	"DATE",
+	"DATETIME",
+	"DECIMAL",
+	"FLOAT64",
+	"GEOGRAPHY",
+	"INT",
+	"INT64",
+	"INTEGER",
+	"JSON",
+	"NUMERIC",
+	"SMALLINT",
+	"STRING",
+	"STRUCT",
+	"TIME",
+	"TIMESTAMP",
+	"TINYINT",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ACOSH",
+	"ANY_VALUE",
+	"APPROX_COUNT_DISTINCT",
+	"APPROX_QUANTILES",
+	"APPROX_TOP_COUNT",
+	"APPROX_TOP_SUM",
+	"ARRAY_AGG",
+	"ARRAY_CONCAT",
+	"ARRAY_CONCAT_AGG",
+	"ARRAY_FIRST",
+	"ARRAY_INCLUDES",
+	"ARRAY_LAST",
+	"ARRAY_LENGTH",
+	"ARRAY_REVERSE",
+	"ARRAY_SLICE",
+	"ARRAY_TO_STRING",
+	"ASCII",
+	"ASIN",
+	"ASINH",
+	"ATAN",
+	"ATAN2",
+	"ATANH",
+	"AVG",
+	"BIT_AND",
+	"BIT_COUNT",
+	"BIT_OR",
+	"BIT_XOR",
+	"BYTE_LENGTH",
+	"CAST",
+	"CBRT",
+	"CEIL",
+	"CEILING",
+	"CHARACTER_LENGTH",
+	"CHAR_LENGTH",
+	"CHR",
+	"COALESCE",
+	"CODE_POINTS_TO_BYTES",
+	"CODE_POINTS_TO_STRING",
+	"COLLATE",
+	"CONCAT",
+	"CONTAINS_SUBSTR",
+	"CORR",
+	"COS",
+	"COSH",
+	"COUNT",
+	"COUNTIF",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"CUME_DIST",
+	"CURRENT_DATE",
+	"CURRENT_DATETIME",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"DATETIME_ADD",
+	"DATETIME_BUCKET",
+	"DATETIME_DIFF",
+	"DATETIME_SUB",
+	"DATETIME_TRUNC",
+	"DATE_ADD",
+	"DATE_BUCKET",
+	"DATE_DIFF",
+	"DATE_FROM_UNIX_DATE",
+	"DATE_SUB",
+	"DATE_TRUNC",
+	"DENSE_RANK",
+	"DIV",
+	"ENDS_WITH",
+	"ERROR",
+	"EXP",
+	"EXTRACT",
+	"FARM_FINGERPRINT",
+	"FIRST_VALUE",
+	"FLOOR",
+	"FORMAT",
+	"FORMAT_DATE",
+	"FORMAT_DATETIME",
+	"FORMAT_TIME",
+	"FORMAT_TIMESTAMP",
+	"FROM_BASE32",
+	"FROM_BASE64",
+	"FROM_HEX",
+	"GENERATE_ARRAY",
+	"GENERATE_DATE_ARRAY",
+	"GENERATE_TIMESTAMP_ARRAY",
+	"GENERATE_UUID",
+	"GREATEST",
+	"GROUPING",
+	"IEEE_DIVIDE",
+	"IF",
+	"IFERROR",
+	"IFNULL",
+	"INITCAP",
+	"INSTR",
+	"ISERROR",
+	"IS_INF",
+	"IS_NAN",
+	"JSON_EXTRACT",
+	"JSON_EXTRACT_ARRAY",
+	"JSON_EXTRACT_SCALAR",
+	"JSON_EXTRACT_STRING_ARRAY",
+	"JSON_QUERY",
+	"JSON_QUERY_ARRAY",
+	"JSON_TYPE",
+	"JSON_VALUE",
+	"JSON_VALUE_ARRAY",
+	"LAG",
+	"LAST_DAY",
+	"LAST_VALUE",
+	"LAX_BOOL",
+	"LAX_FLOAT64",
+	"LAX_INT64",
+	"LAX_STRING",
+	"LEAD",
+	"LEAST",
+	"LEFT",
+	"LENGTH",
+	"LN",
+	"LOG",
+	"LOG10",
+	"LOGICAL_AND",
+	"LOGICAL_OR",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MAX",
+	"MAX_BY",
+	"MD5",
+	"MIN",
+	"MIN_BY",
+	"MOD",
+	"NORMALIZE",
+	"NORMALIZE_AND_CASEFOLD",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"OCTET_LENGTH",
+	"OFFSET",
+	"ORDINAL",
+	"PARSE_BIGNUMERIC",
+	"PARSE_DATE",
+	"PARSE_DATETIME",
+	"PARSE_JSON",
+	"PARSE_NUMERIC",
+	"PARSE_TIME",
+	"PARSE_TIMESTAMP",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"PERCENT_RANK",
+	"POW",
+	"POWER",
+	"RAND",
+	"RANGE_BUCKET",
+	"RANK",
+	"REGEXP_CONTAINS",
+	"REGEXP_EXTRACT",
+	"REGEXP_EXTRACT_ALL",
+	"REGEXP_INSTR",
+	"REGEXP_REPLACE",
+	"REGEXP_SUBSTR",
+	"REPEAT",
+	"REPLACE",
+	"REVERSE",
+	"RIGHT",
+	"ROUND",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SAFE_ADD",
+	"SAFE_CAST",
+	"SAFE_CONVERT_BYTES_TO_STRING",
+	"SAFE_DIVIDE",
+	"SAFE_MULTIPLY",
+	"SAFE_NEGATE",
+	"SAFE_OFFSET",
+	"SAFE_ORDINAL",
+	"SAFE_SUBTRACT",
+	"SESSION_USER",
+	"SHA1",
+	"SHA256",
+	"SHA512",
+	"SIGN",
+	"SIN",
+	"SINH",
+	"SOUNDEX",
+	"SPLIT",
+	"SQRT",
+	"STARTS_WITH",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"STRING_AGG",
+	"STRPOS",
+	"ST_AREA",
+	"ST_ASGEOJSON",
+	"ST_ASTEXT",
+	"ST_CONTAINS",
+	"ST_DISTANCE",
+	"ST_GEOGFROMTEXT",
+	"ST_GEOGPOINT",
+	"ST_INTERSECTS",
+	"ST_X",
+	"ST_Y",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUM",
+	"TAN",
+	"TANH",
+	"TIMESTAMP_ADD",
+	"TIMESTAMP_BUCKET",
+	"TIMESTAMP_DIFF",
+	"TIMESTAMP_MICROS",
+	"TIMESTAMP_MILLIS",
+	"TIMESTAMP_SECONDS",
+	"TIMESTAMP_SUB",
+	"TIMESTAMP_TRUNC",
+	"TIME_ADD",
+	"TIME_DIFF",
+	"TIME_SUB",
+	"TIME_TRUNC",
+	"TO_BASE32",
+	"TO_BASE64",
+	"TO_CODE_POINTS",
+	"TO_HEX",
+	"TO_JSON",
+	"TO_JSON_STRING",
+	"TRANSLATE",
+	"TRIM",
+	"TRUNC",
+	"UNICODE",
+	"UNIX_DATE",
+	"UNIX_MICROS",
+	"UNIX_MILLIS",
+	"UNIX_SECONDS",
+	"UPPER",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+}
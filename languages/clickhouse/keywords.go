@@ -0,0 +1,348 @@
+package clickhouse
+
+var Keywords = []string{
+	"ALIAS",
+	"ALL",
+	"AND",
+	"ANTI",
+	"ANY",
+	"ARRAY",
+	"AS",
+	"ASC",
+	"ASOF",
+	"ASYNC",
+	"ATTACH",
+	"BETWEEN",
+	"BOTH",
+	"BY",
+	"CASCADE",
+	"CAST",
+	"CHECK",
+	"CLUSTER",
+	"CODEC",
+	"COLLATE",
+	"COLUMN",
+	"COMMENT",
+	"CONSTRAINT",
+	"CROSS",
+	"CUBE",
+	"DATABASE",
+	"DEDUPLICATE",
+	"DEFAULT",
+	"DELAY",
+	"DELETE",
+	"DESC",
+	"DETACH",
+	"DICTIONARY",
+	"DISK",
+	"DISTINCT",
+	"DROP",
+	"ELSE",
+	"END",
+	"ENGINE",
+	"EPHEMERAL",
+	"EXCEPT",
+	"EXISTS",
+	"EXPLAIN",
+	"EXPRESSION",
+	"EXTRACT",
+	"FALSE",
+	"FETCH",
+	"FILL",
+	"FINAL",
+	"FIRST",
+	"FOR",
+	"FREEZE",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GLOBAL",
+	"GRANULARITY",
+	"GROUP",
+	"HAVING",
+	"IF",
+	"ILIKE",
+	"IN",
+	"INDEX",
+	"INF",
+	"INNER",
+	"INTERPOLATE",
+	"INTERVAL",
+	"INTO",
+	"IS",
+	"JOIN",
+	"KEY",
+	"LAYOUT",
+	"LEADING",
+	"LEFT",
+	"LIFETIME",
+	"LIKE",
+	"LIMIT",
+	"LIVE",
+	"LOCAL",
+	"MATERIALIZE",
+	"MATERIALIZED",
+	"MOVE",
+	"NAN",
+	"NO",
+	"NOT",
+	"NULL",
+	"NULLS",
+	"OFFSET",
+	"ON",
+	"OR",
+	"OUTER",
+	"OVER",
+	"PARTITION",
+	"PASTE",
+	"POPULATE",
+	"PRIMARY",
+	"PROJECTION",
+	"RANGE",
+	"RECOMPRESS",
+	"REFRESH",
+	"RENAME",
+	"REPLACE",
+	"RIGHT",
+	"ROLLUP",
+	"SAMPLE",
+	"SEMI",
+	"SOURCE",
+	"STEP",
+	"SYNC",
+	"SYSTEM",
+	"TABLE",
+	"TEMPORARY",
+	"THEN",
+	"TIES",
+	"TO",
+	"TOTALS",
+	"TRAILING",
+	"TRUE",
+	"TTL",
+	"UNION",
+	"USING",
+	"VIEW",
+	"VOLUME",
+	"WATCH",
+	"WHEN",
+	"WHERE",
+	"WITH",
+}
+
+var DataTypes = []string{
+	"AggregateFunction",
+	"Array",
+	"BIGINT",
+	"BINARY",
+	"BLOB",
+	"Bool",
+	"BOOLEAN",
+	"BYTEA",
+	"CHAR",
+	"CHARACTER",
+	"Date",
+	"Date32",
+	"DateTime",
+	"DateTime64",
+	"DEC",
+	"DECIMAL",
+	"Decimal",
+	"Decimal128",
+	"Decimal256",
+	"Decimal32",
+	"Decimal64",
+	"DOUBLE",
+	"Dynamic",
+	"Enum",
+	"Enum16",
+	"Enum8",
+	"FixedString",
+	"FLOAT",
+	"Float32",
+	"Float64",
+	"INT",
+	"Int128",
+	"Int16",
+	"Int256",
+	"Int32",
+	"Int64",
+	"Int8",
+	"INTEGER",
+	"IPv4",
+	"IPv6",
+	"JSON",
+	"LONGTEXT",
+	"LowCardinality",
+	"Map",
+	"Nested",
+	"Nothing",
+	"Nullable",
+	"NUMERIC",
+	"Object",
+	"Point",
+	"Polygon",
+	"REAL",
+	"Ring",
+	"SimpleAggregateFunction",
+	"SMALLINT",
+	"String",
+	"TEXT",
+	"TIMESTAMP",
+	"TINYINT",
+	"Tuple",
+	"UInt128",
+	"UInt16",
+	"UInt256",
+	"UInt32",
+	"UInt64",
+	"UInt8",
+	"UUID",
+	"VARBINARY",
+	"VARCHAR",
+	"Variant",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"AggregatingMergeTree",
+	"anyLast",
+	"argMax",
+	"argMin",
+	"arrayConcat",
+	"arrayDistinct",
+	"arrayElement",
+	"arrayEnumerate",
+	"arrayExists",
+	"arrayFilter",
+	"arrayFirst",
+	"arrayJoin",
+	"arrayMap",
+	"arrayReduce",
+	"arraySlice",
+	"arraySort",
+	"arraySum",
+	"ASIN",
+	"ATAN",
+	"avg",
+	"avgIf",
+	"Buffer",
+	"CAST",
+	"CEIL",
+	"CEILING",
+	"COALESCE",
+	"CollapsingMergeTree",
+	"CONCAT",
+	"COS",
+	"COUNT",
+	"count",
+	"countIf",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"DATE_TRUNC",
+	"DENSE_RANK",
+	"dictGet",
+	"dictGetOrDefault",
+	"dictHas",
+	"Distributed",
+	"empty",
+	"endsWith",
+	"EXP",
+	"extractAll",
+	"FIRST_VALUE",
+	"FLOOR",
+	"formatDateTime",
+	"GREATEST",
+	"groupArray",
+	"groupUniqArray",
+	"has",
+	"hasAll",
+	"hasAny",
+	"if",
+	"ifNull",
+	"indexOf",
+	"isNotNull",
+	"isNull",
+	"JSONExtract",
+	"JSONExtractString",
+	"Kafka",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"LEAST",
+	"length",
+	"LN",
+	"LOG",
+	"Log",
+	"LOG10",
+	"lower",
+	"LTRIM",
+	"match",
+	"max",
+	"maxIf",
+	"median",
+	"Memory",
+	"MergeTree",
+	"min",
+	"minIf",
+	"MOD",
+	"multiIf",
+	"now",
+	"NTILE",
+	"NULLIF",
+	"parseDateTimeBestEffort",
+	"PERCENT_RANK",
+	"position",
+	"POW",
+	"POWER",
+	"quantile",
+	"quantiles",
+	"RANK",
+	"REPLACE",
+	"replaceAll",
+	"replaceOne",
+	"replaceRegexpAll",
+	"ReplacingMergeTree",
+	"ReplicatedMergeTree",
+	"REVERSE",
+	"round",
+	"ROW_NUMBER",
+	"RTRIM",
+	"SIGN",
+	"SIN",
+	"splitByChar",
+	"SQRT",
+	"startsWith",
+	"StripeLog",
+	"SUBSTR",
+	"substring",
+	"sum",
+	"sumIf",
+	"SummingMergeTree",
+	"TAN",
+	"TinyLog",
+	"toDate",
+	"toDateTime",
+	"today",
+	"toDecimal64",
+	"toFloat64",
+	"toInt32",
+	"toInt64",
+	"topK",
+	"toStartOfDay",
+	"toStartOfHour",
+	"toStartOfMonth",
+	"toStartOfWeek",
+	"toString",
+	"toUInt32",
+	"toUInt64",
+	"toYYYYMM",
+	"TRIM",
+	"tuple",
+	"uniq",
+	"uniqExact",
+	"upper",
+	"VersionedCollapsingMergeTree",
+	"yesterday",
+}
@@ -0,0 +1,101 @@
+package duckdb
+
+// The lists below only hold what DuckDB adds on top of the PostgreSQL
+// keywords, data types and functions.
+
+var Keywords = []string{
+	"ANTI",
+	"ASOF",
+	"EXCLUDE",
+	"GLOB",
+	"ILIKE",
+	"MACRO",
+	"ORDINALITY",
+	"PERSISTENT",
+	"POSITIONAL",
+	"PRAGMA",
+	"SAMPLE",
+	"SECRET",
+	"SEMI",
+	"SIMILAR",
+	"SUMMARIZE",
+	"TABLESAMPLE",
+}
+
+var DataTypes = []string{
+	"BLOB",
+	"BYTEA",
+	"DATETIME",
+	"DOUBLE",
+	"FLOAT4",
+	"FLOAT8",
+	"HUGEINT",
+	"INT1",
+	"INT16",
+	"INT2",
+	"INT32",
+	"INT4",
+	"INT64",
+	"INT8",
+	"LIST",
+	"LONG",
+	"MAP",
+	"SHORT",
+	"SIGNED",
+	"STRING",
+	"STRUCT",
+	"TIMESTAMP_MS",
+	"TIMESTAMP_NS",
+	"TIMESTAMP_S",
+	"TINYINT",
+	"UBIGINT",
+	"UHUGEINT",
+	"UINTEGER",
+	"USMALLINT",
+	"UTINYINT",
+	"VARINT",
+}
+
+var Functions = []string{
+	"ANY_VALUE",
+	"ARG_MAX",
+	"ARG_MIN",
+	"ARRAY_AGG",
+	"COLUMNS",
+	"COUNT_STAR",
+	"EPOCH",
+	"EPOCH_MS",
+	"FLATTEN",
+	"HISTOGRAM",
+	"LIST_AGGREGATE",
+	"LIST_APPLY",
+	"LIST_CONCAT",
+	"LIST_CONTAINS",
+	"LIST_DISTINCT",
+	"LIST_EXTRACT",
+	"LIST_FILTER",
+	"LIST_REDUCE",
+	"LIST_SORT",
+	"LIST_TRANSFORM",
+	"LIST_VALUE",
+	"MAP_EXTRACT",
+	"MAP_FROM_ENTRIES",
+	"MAP_KEYS",
+	"MAP_VALUES",
+	"QUANTILE_CONT",
+	"QUANTILE_DISC",
+	"READ_CSV",
+	"READ_CSV_AUTO",
+	"READ_JSON",
+	"READ_JSON_AUTO",
+	"READ_PARQUET",
+	"REGEXP_EXTRACT",
+	"REGEXP_MATCHES",
+	"STRFTIME",
+	"STRPTIME",
+	"STRUCT_EXTRACT",
+	"STRUCT_INSERT",
+	"STRUCT_PACK",
+	"TIME_BUCKET",
+	"UNNEST",
+}
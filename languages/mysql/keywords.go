@@ -0,0 +1,542 @@
+package mysql
+
+var Keywords = []string{
+	"ACCESSIBLE",
+	"ADD",
+	"ALL",
+	"ALTER",
+	"ANALYZE",
+	"AND",
+	"AS",
+	"ASC",
+	"ASENSITIVE",
+	"BEFORE",
+	"BETWEEN",
+	"BOTH",
+	"BY",
+	"CALL",
+	"CASCADE",
+	"CASE",
+	"CHANGE",
+	"CHECK",
+	"COLLATE",
+	"COLUMN",
+	"CONDITION",
+	"CONSTRAINT",
+	"CONTINUE",
+	"CONVERT",
+	"CREATE",
+	"CROSS",
+	"CUBE",
+	"CUME_DIST",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
+	"CURSOR",
+	"DATABASE",
+	"DATABASES",
+	"DAY_HOUR",
+	"DAY_MICROSECOND",
+	"DAY_MINUTE",
+	"DAY_SECOND",
+	"DECLARE",
+	"DEFAULT",
+	"DELAYED",
+	"DELETE",
+	"DENSE_RANK",
+	"DESC",
+	"DESCRIBE",
+	"DETERMINISTIC",
+	"DISTINCT",
+	"DISTINCTROW",
+	"DIV",
+	"DROP",
+	"DUAL",
+	"DUPLICATE",
+	"EACH",
+	"ELSE",
+	"ELSEIF",
+	"EMPTY",
+	"ENCLOSED",
+	"ENGINE",
+	"ESCAPED",
+	"EXCEPT",
+	"EXISTS",
+	"EXIT",
+	"EXPLAIN",
+	"FALSE",
+	"FETCH",
+	"FIRST_VALUE",
+	"FOR",
+	"FORCE",
+	"FOREIGN",
+	"FROM",
+	"FULLTEXT",
+	"FUNCTION",
+	"GENERATED",
+	"GET",
+	"GRANT",
+	"GROUP",
+	"GROUPING",
+	"GROUPS",
+	"HAVING",
+	"HIGH_PRIORITY",
+	"HOUR_MICROSECOND",
+	"HOUR_MINUTE",
+	"HOUR_SECOND",
+	"IF",
+	"IGNORE",
+	"IN",
+	"INDEX",
+	"INFILE",
+	"INNER",
+	"INOUT",
+	"INSENSITIVE",
+	"INSERT",
+	"INTERSECT",
+	"INTERVAL",
+	"INTO",
+	"IO_AFTER_GTIDS",
+	"IO_BEFORE_GTIDS",
+	"IS",
+	"ITERATE",
+	"JOIN",
+	"JSON_TABLE",
+	"KEY",
+	"KEYS",
+	"KILL",
+	"LAG",
+	"LAST_VALUE",
+	"LATERAL",
+	"LEAD",
+	"LEADING",
+	"LEAVE",
+	"LEFT",
+	"LIKE",
+	"LIMIT",
+	"LINEAR",
+	"LINES",
+	"LOAD",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"LOCK",
+	"LOOP",
+	"LOW_PRIORITY",
+	"MASTER_BIND",
+	"MASTER_SSL_VERIFY_SERVER_CERT",
+	"MATCH",
+	"MAXVALUE",
+	"MINUTE_MICROSECOND",
+	"MINUTE_SECOND",
+	"MOD",
+	"MODIFIES",
+	"NATURAL",
+	"NOT",
+	"NO_WRITE_TO_BINLOG",
+	"NTH_VALUE",
+	"NTILE",
+	"NULL",
+	"OF",
+	"ON",
+	"OPTIMIZE",
+	"OPTIMIZER_COSTS",
+	"OPTION",
+	"OPTIONALLY",
+	"OR",
+	"ORDER",
+	"OUT",
+	"OUTER",
+	"OUTFILE",
+	"OVER",
+	"PARTITION",
+	"PERCENT_RANK",
+	"PRIMARY",
+	"PROCEDURE",
+	"PURGE",
+	"RANGE",
+	"RANK",
+	"READ",
+	"READS",
+	"READ_WRITE",
+	"RECURSIVE",
+	"REFERENCES",
+	"REGEXP",
+	"RELEASE",
+	"RENAME",
+	"REPEAT",
+	"REPLACE",
+	"REQUIRE",
+	"RESIGNAL",
+	"RESTRICT",
+	"RETURN",
+	"REVOKE",
+	"RIGHT",
+	"RLIKE",
+	"ROW",
+	"ROWS",
+	"ROW_NUMBER",
+	"SCHEMA",
+	"SCHEMAS",
+	"SECOND_MICROSECOND",
+	"SELECT",
+	"SENSITIVE",
+	"SEPARATOR",
+	"SET",
+	"SHOW",
+	"SIGNAL",
+	"SPATIAL",
+	"SPECIFIC",
+	"SQL",
+	"SQLEXCEPTION",
+	"SQLSTATE",
+	"SQLWARNING",
+	"SQL_BIG_RESULT",
+	"SQL_CALC_FOUND_ROWS",
+	"SQL_SMALL_RESULT",
+	"SSL",
+	"STARTING",
+	"STORED",
+	"STRAIGHT_JOIN",
+	"SYSTEM",
+	"TABLE",
+	"TERMINATED",
+	"THEN",
+	"TO",
+	"TRAILING",
+	"TRIGGER",
+	"TRUE",
+	"UNDO",
+	"UNION",
+	"UNIQUE",
+	"UNLOCK",
+	"UNSIGNED",
+	"UPDATE",
+	"USAGE",
+	"USE",
+	"USING",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"VALUES",
+	"VIRTUAL",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WINDOW",
+	"WITH",
+	"WRITE",
+	"XOR",
+	"YEAR_MONTH",
+	"ZEROFILL",
+}
+
+var DataTypes = []string{
+	"BIGINT",
+	"BINARY",
+	"BIT",
+	"BLOB",
+	"BOOL",
+	"BOOLEAN",
+	"CHAR",
+	"CHARACTER",
+	"DATE",
+	"DATETIME",
+	"DEC",
+	"DECIMAL",
+	"DOUBLE",
+	"ENUM",
+	"FIXED",
+	"FLOAT",
+	"FLOAT4",
+	"FLOAT8",
+	"GEOMETRY",
+	"GEOMETRYCOLLECTION",
+	"INT",
+	"INT1",
+	"INT2",
+	"INT3",
+	"INT4",
+	"INT8",
+	"INTEGER",
+	"JSON",
+	"LINESTRING",
+	"LONG",
+	"LONGBLOB",
+	"LONGTEXT",
+	"MEDIUMBLOB",
+	"MEDIUMINT",
+	"MEDIUMTEXT",
+	"MIDDLEINT",
+	"MULTILINESTRING",
+	"MULTIPOINT",
+	"MULTIPOLYGON",
+	"NATIONAL",
+	"NCHAR",
+	"NUMERIC",
+	"NVARCHAR",
+	"POINT",
+	"POLYGON",
+	"PRECISION",
+	"REAL",
+	"SMALLINT",
+	"TEXT",
+	"TIME",
+	"TIMESTAMP",
+	"TINYBLOB",
+	"TINYINT",
+	"TINYTEXT",
+	"VARBINARY",
+	"VARCHAR",
+	"VARCHARACTER",
+	"VARYING",
+	"YEAR",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ADDDATE",
+	"ADDTIME",
+	"AES_DECRYPT",
+	"AES_ENCRYPT",
+	"ANY_VALUE",
+	"ASCII",
+	"ASIN",
+	"ATAN",
+	"ATAN2",
+	"AVG",
+	"BENCHMARK",
+	"BIN",
+	"BIN_TO_UUID",
+	"BIT_AND",
+	"BIT_COUNT",
+	"BIT_LENGTH",
+	"BIT_OR",
+	"BIT_XOR",
+	"CAST",
+	"CEIL",
+	"CEILING",
+	"CHARACTER_LENGTH",
+	"CHARSET",
+	"CHAR_LENGTH",
+	"COALESCE",
+	"COERCIBILITY",
+	"COLLATION",
+	"COMPRESS",
+	"CONCAT",
+	"CONCAT_WS",
+	"CONNECTION_ID",
+	"CONV",
+	"CONVERT_TZ",
+	"COS",
+	"COT",
+	"COUNT",
+	"CRC32",
+	"CUME_DIST",
+	"CURDATE",
+	"CURRENT_ROLE",
+	"CURTIME",
+	"DATABASE",
+	"DATEDIFF",
+	"DATE_ADD",
+	"DATE_FORMAT",
+	"DATE_SUB",
+	"DAY",
+	"DAYNAME",
+	"DAYOFMONTH",
+	"DAYOFWEEK",
+	"DAYOFYEAR",
+	"DEGREES",
+	"DENSE_RANK",
+	"ELT",
+	"EXP",
+	"EXPORT_SET",
+	"EXTRACT",
+	"EXTRACTVALUE",
+	"FIELD",
+	"FIND_IN_SET",
+	"FIRST_VALUE",
+	"FLOOR",
+	"FORMAT",
+	"FORMAT_BYTES",
+	"FORMAT_PICO_TIME",
+	"FOUND_ROWS",
+	"FROM_BASE64",
+	"FROM_DAYS",
+	"FROM_UNIXTIME",
+	"GET_FORMAT",
+	"GET_LOCK",
+	"GREATEST",
+	"GROUPING",
+	"GROUP_CONCAT",
+	"GTID_SUBSET",
+	"GTID_SUBTRACT",
+	"HEX",
+	"HOUR",
+	"ICU_VERSION",
+	"IFNULL",
+	"INET6_ATON",
+	"INET6_NTOA",
+	"INET_ATON",
+	"INET_NTOA",
+	"INSTR",
+	"ISNULL",
+	"IS_FREE_LOCK",
+	"IS_IPV4",
+	"IS_IPV6",
+	"IS_USED_LOCK",
+	"IS_UUID",
+	"JSON_ARRAY",
+	"JSON_ARRAYAGG",
+	"JSON_ARRAY_APPEND",
+	"JSON_ARRAY_INSERT",
+	"JSON_CONTAINS",
+	"JSON_CONTAINS_PATH",
+	"JSON_DEPTH",
+	"JSON_EXTRACT",
+	"JSON_INSERT",
+	"JSON_KEYS",
+	"JSON_LENGTH",
+	"JSON_MERGE_PATCH",
+	"JSON_MERGE_PRESERVE",
+	"JSON_OBJECT",
+	"JSON_OBJECTAGG",
+	"JSON_OVERLAPS",
+	"JSON_PRETTY",
+	"JSON_QUOTE",
+	"JSON_REMOVE",
+	"JSON_REPLACE",
+	"JSON_SCHEMA_VALID",
+	"JSON_SEARCH",
+	"JSON_SET",
+	"JSON_STORAGE_SIZE",
+	"JSON_TYPE",
+	"JSON_UNQUOTE",
+	"JSON_VALID",
+	"JSON_VALUE",
+	"LAG",
+	"LAST_DAY",
+	"LAST_INSERT_ID",
+	"LAST_VALUE",
+	"LCASE",
+	"LEAD",
+	"LEAST",
+	"LENGTH",
+	"LN",
+	"LOAD_FILE",
+	"LOCATE",
+	"LOG",
+	"LOG10",
+	"LOG2",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MAKEDATE",
+	"MAKETIME",
+	"MAKE_SET",
+	"MAX",
+	"MD5",
+	"MEMBER",
+	"MICROSECOND",
+	"MID",
+	"MIN",
+	"MINUTE",
+	"MONTH",
+	"MONTHNAME",
+	"NAME_CONST",
+	"NOW",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"OCT",
+	"OCTET_LENGTH",
+	"ORD",
+	"PERCENT_RANK",
+	"PERIOD_ADD",
+	"PERIOD_DIFF",
+	"PI",
+	"POSITION",
+	"POW",
+	"POWER",
+	"QUARTER",
+	"QUOTE",
+	"RADIANS",
+	"RAND",
+	"RANDOM_BYTES",
+	"RANK",
+	"REGEXP_INSTR",
+	"REGEXP_LIKE",
+	"REGEXP_REPLACE",
+	"REGEXP_SUBSTR",
+	"RELEASE_ALL_LOCKS",
+	"RELEASE_LOCK",
+	"REVERSE",
+	"ROLES_GRAPHML",
+	"ROUND",
+	"ROW_COUNT",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SCHEMA",
+	"SECOND",
+	"SEC_TO_TIME",
+	"SESSION_USER",
+	"SHA1",
+	"SHA2",
+	"SIGN",
+	"SIN",
+	"SLEEP",
+	"SOUNDEX",
+	"SPACE",
+	"SQRT",
+	"STATEMENT_DIGEST",
+	"STATEMENT_DIGEST_TEXT",
+	"STD",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"STRCMP",
+	"STR_TO_DATE",
+	"SUBDATE",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUBSTRING_INDEX",
+	"SUBTIME",
+	"SUM",
+	"SYSDATE",
+	"SYSTEM_USER",
+	"TAN",
+	"TIMEDIFF",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"TIME_FORMAT",
+	"TIME_TO_SEC",
+	"TO_BASE64",
+	"TO_DAYS",
+	"TO_SECONDS",
+	"TRIM",
+	"TRUNCATE",
+	"UCASE",
+	"UNCOMPRESS",
+	"UNCOMPRESSED_LENGTH",
+	"UNHEX",
+	"UNIX_TIMESTAMP",
+	"UPDATEXML",
+	"UPPER",
+	"USER",
+	"UUID",
+	"UUID_SHORT",
+	"UUID_TO_BIN",
+	"VALIDATE_PASSWORD_STRENGTH",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"VERSION",
+	"WAIT_FOR_EXECUTED_GTID_SET",
+	"WEEK",
+	"WEEKDAY",
+	"WEEKOFYEAR",
+	"WEIGHT_STRING",
+	"YEARWEEK",
+}
@@ -0,0 +1,514 @@
+package plsql
+
+var Keywords = []string{
+	"ACCESS",
+	"ADD",
+	"AFTER",
+	"AGGREGATE",
+	"ALL",
+	"ALTER",
+	"ANALYZE",
+	"AND",
+	"ANY",
+	"ARRAY",
+	"AS",
+	"ASC",
+	"ASSOCIATE",
+	"AT",
+	"AUDIT",
+	"AUTHID",
+	"AUTOMATIC",
+	"AUTONOMOUS_TRANSACTION",
+	"BEFORE",
+	"BEGIN",
+	"BETWEEN",
+	"BODY",
+	"BOTH",
+	"BULK",
+	"BULK_EXCEPTIONS",
+	"BULK_ROWCOUNT",
+	"BY",
+	"CALL",
+	"CASCADE",
+	"CASE",
+	"CHECK",
+	"CLOSE",
+	"CLUSTER",
+	"COLLECT",
+	"COLUMN",
+	"COLUMNS",
+	"COMMENT",
+	"COMMIT",
+	"COMMITTED",
+	"COMPOUND",
+	"COMPRESS",
+	"CONNECT",
+	"CONNECT_BY_ROOT",
+	"CONSTANT",
+	"CONSTRAINT",
+	"CONSTRAINTS",
+	"CONTINUE",
+	"CREATE",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_USER",
+	"CURSOR",
+	"CYCLE",
+	"DATABASE",
+	"DAY",
+	"DECLARE",
+	"DEFAULT",
+	"DEFERRABLE",
+	"DEFERRED",
+	"DEFINER",
+	"DELETE",
+	"DESC",
+	"DETERMINISTIC",
+	"DISABLE",
+	"DISTINCT",
+	"DROP",
+	"EACH",
+	"EDITIONABLE",
+	"ELSE",
+	"ELSIF",
+	"ENABLE",
+	"END",
+	"ESCAPE",
+	"EXCEPTION",
+	"EXCEPTIONS",
+	"EXCEPTION_INIT",
+	"EXCLUSIVE",
+	"EXECUTE",
+	"EXISTS",
+	"EXIT",
+	"EXTERNAL",
+	"FALSE",
+	"FETCH",
+	"FILE",
+	"FIRST",
+	"FOLLOWING",
+	"FOR",
+	"FORALL",
+	"FORCE",
+	"FOREIGN",
+	"FOUND",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GOTO",
+	"GRANT",
+	"GROUP",
+	"HAVING",
+	"IDENTIFIED",
+	"IF",
+	"IMMEDIATE",
+	"IN",
+	"INCREMENT",
+	"INDEX",
+	"INDICES",
+	"INITIALLY",
+	"INNER",
+	"INSERT",
+	"INSTEAD",
+	"INTERSECT",
+	"INTERVAL",
+	"INTO",
+	"IS",
+	"ISOLATION",
+	"ISOPEN",
+	"JAVA",
+	"JOIN",
+	"KEY",
+	"LANGUAGE",
+	"LAST",
+	"LEADING",
+	"LEFT",
+	"LEVEL",
+	"LIKE",
+	"LIKE2",
+	"LIKE4",
+	"LIKEC",
+	"LIMIT",
+	"LOCAL",
+	"LOCALTIMESTAMP",
+	"LOCK",
+	"LOG",
+	"LOOP",
+	"MATCHED",
+	"MAXVALUE",
+	"MERGE",
+	"MINUS",
+	"MINUTE",
+	"MINVALUE",
+	"MODE",
+	"MODIFY",
+	"MONTH",
+	"NATURAL",
+	"NEXT",
+	"NO",
+	"NOCOMPRESS",
+	"NOCOPY",
+	"NOCYCLE",
+	"NONE",
+	"NONEDITIONABLE",
+	"NOORDER",
+	"NOT",
+	"NOTFOUND",
+	"NOWAIT",
+	"NULL",
+	"NULLS",
+	"OF",
+	"OFFSET",
+	"OLD",
+	"ON",
+	"ONLY",
+	"OPEN",
+	"OPTION",
+	"OR",
+	"ORDER",
+	"OTHERS",
+	"OUT",
+	"OUTER",
+	"OVER",
+	"PACKAGE",
+	"PARALLEL_ENABLE",
+	"PARTITION",
+	"PERCENT",
+	"PIPE",
+	"PIPELINED",
+	"PIVOT",
+	"PRAGMA",
+	"PRECEDING",
+	"PRIMARY",
+	"PRIOR",
+	"PRIVATE",
+	"PROCEDURE",
+	"PUBLIC",
+	"RAISE",
+	"RANGE",
+	"READ",
+	"RECORD",
+	"REF",
+	"REFERENCES",
+	"REFERENCING",
+	"RELIES_ON",
+	"RENAME",
+	"REPLACE",
+	"RESTRICT_REFERENCES",
+	"RESULT_CACHE",
+	"RETURN",
+	"RETURNING",
+	"REUSE",
+	"REVERSE",
+	"REVOKE",
+	"RIGHT",
+	"ROLLBACK",
+	"ROW",
+	"ROWCOUNT",
+	"ROWID",
+	"ROWNUM",
+	"ROWS",
+	"ROWTYPE",
+	"SAMPLE",
+	"SAVEPOINT",
+	"SCHEMA",
+	"SECOND",
+	"SEED",
+	"SEGMENT",
+	"SELECT",
+	"SEQUENCE",
+	"SERIALIZABLE",
+	"SERIALLY_REUSABLE",
+	"SESSION",
+	"SET",
+	"SHARE",
+	"SIBLINGS",
+	"SIZE",
+	"SOME",
+	"SQL",
+	"SQLCODE",
+	"SQLERRM",
+	"START",
+	"STATEMENT",
+	"SUBTYPE",
+	"SYNONYM",
+	"SYSDATE",
+	"SYSTIMESTAMP",
+	"TABLE",
+	"TEMPORARY",
+	"THEN",
+	"TIES",
+	"TIME",
+	"TIMEZONE_ABBR",
+	"TIMEZONE_HOUR",
+	"TIMEZONE_MINUTE",
+	"TIMEZONE_REGION",
+	"TO",
+	"TRAILING",
+	"TRANSACTION",
+	"TRIGGER",
+	"TRUE",
+	"TRUNCATE",
+	"TYPE",
+	"UID",
+	"UNBOUNDED",
+	"UNDER",
+	"UNION",
+	"UNIQUE",
+	"UNLIMITED",
+	"UNPIVOT",
+	"UNTIL",
+	"UPDATE",
+	"USE",
+	"USER",
+	"USING",
+	"VALIDATE",
+	"VALUES",
+	"VIEW",
+	"WAIT",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WITH",
+	"WORK",
+	"WRITE",
+	"YEAR",
+	"ZONE",
+}
+
+var DataTypes = []string{
+	"BFILE",
+	"BINARY_DOUBLE",
+	"BINARY_FLOAT",
+	"BINARY_INTEGER",
+	"BLOB",
+	"BOOLEAN",
+	"CHAR",
+	"CHARACTER",
+	"CLOB",
+	"DATE",
+	"DEC",
+	"DECIMAL",
+	"DOUBLE",
+	"FLOAT",
+	"INT",
+	"INTEGER",
+	"LONG",
+	"MLSLABEL",
+	"NATURALN",
+	"NCHAR",
+	"NCLOB",
+	"NUMBER",
+	"NUMERIC",
+	"NVARCHAR2",
+	"PLS_INTEGER",
+	"POSITIVE",
+	"POSITIVEN",
+	"RAW",
+	"REAL",
+	"SIGNTYPE",
+	"SIMPLE_INTEGER",
+	"SMALLINT",
+	"TIMESTAMP",
+	"UROWID",
+	"VARCHAR",
+	"VARCHAR2",
+	"XMLTYPE",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ADD_MONTHS",
+	"APPENDCHILDXML",
+	"ASCII",
+	"ASCIISTR",
+	"ASIN",
+	"ATAN",
+	"ATAN2",
+	"AVG",
+	"BFILENAME",
+	"BIN_TO_NUM",
+	"BITAND",
+	"CARDINALITY",
+	"CAST",
+	"CEIL",
+	"CHARTOROWID",
+	"CHR",
+	"COALESCE",
+	"COLLECT",
+	"COMPOSE",
+	"CONCAT",
+	"CONVERT",
+	"CORR",
+	"CORR_K",
+	"CORR_S",
+	"COS",
+	"COSH",
+	"COUNT",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"CUME_DIST",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"CV",
+	"DBTIMEZONE",
+	"DECODE",
+	"DECOMPOSE",
+	"DENSE_RANK",
+	"DEPTH",
+	"DEREF",
+	"DUMP",
+	"EMPTY_BLOB",
+	"EMPTY_CLOB",
+	"EXISTSNODE",
+	"EXP",
+	"EXTRACT",
+	"EXTRACTVALUE",
+	"FIRST_VALUE",
+	"FLOOR",
+	"FROM_TZ",
+	"GREATEST",
+	"GROUPING",
+	"GROUPING_ID",
+	"GROUP_ID",
+	"HEXTORAW",
+	"INITCAP",
+	"INSTR",
+	"INSTRB",
+	"JSON_ARRAY",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECT",
+	"JSON_OBJECTAGG",
+	"JSON_QUERY",
+	"JSON_TABLE",
+	"JSON_VALUE",
+	"LAG",
+	"LAST_DAY",
+	"LAST_VALUE",
+	"LEAD",
+	"LEAST",
+	"LENGTH",
+	"LENGTHB",
+	"LISTAGG",
+	"LN",
+	"LNNVL",
+	"LOCALTIMESTAMP",
+	"LOG",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MAKE_REF",
+	"MAX",
+	"MEDIAN",
+	"MIN",
+	"MOD",
+	"MONTHS_BETWEEN",
+	"NANVL",
+	"NCHR",
+	"NEW_TIME",
+	"NEXT_DAY",
+	"NLSSORT",
+	"NLS_CHARSET_DECL_LEN",
+	"NLS_CHARSET_ID",
+	"NLS_CHARSET_NAME",
+	"NLS_INITCAP",
+	"NLS_LOWER",
+	"NLS_UPPER",
+	"NTILE",
+	"NULLIF",
+	"NUMTODSINTERVAL",
+	"NUMTOYMINTERVAL",
+	"NVL",
+	"NVL2",
+	"ORA_HASH",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"PERCENT_RANK",
+	"POWER",
+	"RANK",
+	"RATIO_TO_REPORT",
+	"RAWTOHEX",
+	"RAWTONHEX",
+	"REGEXP_COUNT",
+	"REGEXP_INSTR",
+	"REGEXP_LIKE",
+	"REGEXP_REPLACE",
+	"REGEXP_SUBSTR",
+	"REMAINDER",
+	"REPLACE",
+	"ROUND",
+	"ROWIDTOCHAR",
+	"ROWIDTONCHAR",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SCN_TO_TIMESTAMP",
+	"SESSIONTIMEZONE",
+	"SIGN",
+	"SIN",
+	"SINH",
+	"SOUNDEX",
+	"SQRT",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"SUBSTR",
+	"SUBSTRB",
+	"SUM",
+	"SYS_CONNECT_BY_PATH",
+	"SYS_CONTEXT",
+	"SYS_EXTRACT_UTC",
+	"SYS_GUID",
+	"SYS_TYPEID",
+	"SYS_XMLAGG",
+	"SYS_XMLGEN",
+	"TAN",
+	"TANH",
+	"TIMESTAMP_TO_SCN",
+	"TO_BINARY_DOUBLE",
+	"TO_BINARY_FLOAT",
+	"TO_CHAR",
+	"TO_CLOB",
+	"TO_DATE",
+	"TO_DSINTERVAL",
+	"TO_LOB",
+	"TO_MULTI_BYTE",
+	"TO_NCHAR",
+	"TO_NCLOB",
+	"TO_NUMBER",
+	"TO_SINGLE_BYTE",
+	"TO_TIMESTAMP",
+	"TO_TIMESTAMP_TZ",
+	"TO_YMINTERVAL",
+	"TRANSLATE",
+	"TREAT",
+	"TRIM",
+	"TRUNC",
+	"TZ_OFFSET",
+	"UNISTR",
+	"UPPER",
+	"USERENV",
+	"VALUE",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"VSIZE",
+	"WIDTH_BUCKET",
+	"XMLAGG",
+	"XMLCAST",
+	"XMLCDATA",
+	"XMLCOLATTVAL",
+	"XMLCOMMENT",
+	"XMLCONCAT",
+	"XMLELEMENT",
+	"XMLEXISTS",
+	"XMLFOREST",
+	"XMLPARSE",
+	"XMLPI",
+	"XMLQUERY",
+	"XMLROOT",
+	"XMLSEQUENCE",
+	"XMLSERIALIZE",
+	"XMLTABLE",
+}
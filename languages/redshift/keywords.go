@@ -0,0 +1,77 @@
+package redshift
+
+// The lists below only hold what Redshift adds on top of the PostgreSQL
+// keywords, data types and functions.
+
+var Keywords = []string{
+	"ACCEPTANYDATE",
+	"ACCEPTINVCHARS",
+	"ALLOWOVERWRITE",
+	"AUTO",
+	"BACKUP",
+	"BLANKSASNULL",
+	"BZIP2",
+	"CLEANPATH",
+	"COMPOUND",
+	"COMPUPDATE",
+	"CREDENTIALS",
+	"CSV",
+	"DATEFORMAT",
+	"DELIMITER",
+	"DISTKEY",
+	"DISTSTYLE",
+	"EMPTYASNULL",
+	"ENCODE",
+	"ENCRYPTED",
+	"ESCAPE",
+	"EVEN",
+	"EXPLICIT_IDS",
+	"EXTENSION",
+	"FIXEDWIDTH",
+	"FORMAT",
+	"GZIP",
+	"HEADER",
+	"IAM_ROLE",
+	"IGNOREHEADER",
+	"INTERLEAVED",
+	"JSON",
+	"KMS_KEY_ID",
+	"MANIFEST",
+	"MAXFILESIZE",
+	"NOLOAD",
+	"PARALLEL",
+	"PARQUET",
+	"PARTITION",
+	"REGION",
+	"REMOVEQUOTES",
+	"SORTKEY",
+	"STATUPDATE",
+	"TIMEFORMAT",
+	"TRUNCATECOLUMNS",
+	"ZSTD",
+}
+
+var DataTypes = []string{
+	"GEOGRAPHY",
+	"GEOMETRY",
+	"HLLSKETCH",
+	"SUPER",
+	"TIMETZ",
+	"VARBYTE",
+}
+
+var Functions = []string{
+	"APPROXIMATE",
+	"CONVERT_TIMEZONE",
+	"DATEADD",
+	"DATEDIFF",
+	"GETDATE",
+	"JSON_EXTRACT_PATH_TEXT",
+	"JSON_PARSE",
+	"JSON_SERIALIZE",
+	"LISTAGG",
+	"MEDIAN",
+	"NVL",
+	"NVL2",
+	"SYSDATE",
+}
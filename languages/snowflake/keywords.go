@@ -0,0 +1,443 @@
+package snowflake
+
+var Keywords = []string{
+	"ACCOUNT",
+	"ALL",
+	"ALTER",
+	"AND",
+	"ANY",
+	"AS",
+	"ASC",
+	"AT",
+	"AUTOINCREMENT",
+	"BEFORE",
+	"BETWEEN",
+	"BY",
+	"CASE",
+	"CAST",
+	"CHANGES",
+	"CHECK",
+	"CLONE",
+	"CLUSTER",
+	"COLLATE",
+	"COLUMN",
+	"COMMENT",
+	"CONNECT",
+	"CONNECTION",
+	"CONSTRAINT",
+	"COPY",
+	"CREATE",
+	"CREDENTIALS",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
+	"DATABASE",
+	"DEFAULT",
+	"DELETE",
+	"DESC",
+	"DISTINCT",
+	"DROP",
+	"ELSE",
+	"EMPTY",
+	"ENCRYPTION",
+	"EXCLUDE",
+	"EXISTS",
+	"FALSE",
+	"FILE_FORMAT",
+	"FIRST",
+	"FOLLOWING",
+	"FOR",
+	"FOREIGN",
+	"FROM",
+	"FULL",
+	"GRANT",
+	"GROUP",
+	"GSCLUSTER",
+	"HAVING",
+	"IDENTITY",
+	"IF",
+	"IGNORE",
+	"ILIKE",
+	"IN",
+	"INCREMENT",
+	"INNER",
+	"INSERT",
+	"INTERSECT",
+	"INTERVAL",
+	"INTO",
+	"IS",
+	"ISSUE",
+	"JOIN",
+	"KEY",
+	"LAST",
+	"LATERAL",
+	"LEFT",
+	"LIKE",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"MATCH",
+	"MATCH_RECOGNIZE",
+	"MINUS",
+	"NATURAL",
+	"NEXT",
+	"NOT",
+	"NULL",
+	"NULLS",
+	"OF",
+	"OMIT",
+	"ON",
+	"ONLY",
+	"OPTIONS",
+	"OR",
+	"ORDER",
+	"ORGANIZATION",
+	"OVER",
+	"OVERWRITE",
+	"PARTITION",
+	"PAST",
+	"PATTERN",
+	"PER",
+	"PERMUTE",
+	"PIPE",
+	"PRIMARY",
+	"QUALIFY",
+	"RECURSIVE",
+	"REFERENCES",
+	"REGEXP",
+	"RENAME",
+	"REPLACE",
+	"RESPECT",
+	"REVOKE",
+	"RIGHT",
+	"RLIKE",
+	"ROLE",
+	"ROW",
+	"ROWS",
+	"SAMPLE",
+	"SCHEMA",
+	"SECURE",
+	"SELECT",
+	"SEQUENCE",
+	"SET",
+	"SHOW",
+	"SKIP",
+	"SOME",
+	"STAGE",
+	"START",
+	"STORAGE_INTEGRATION",
+	"STREAM",
+	"TABLE",
+	"TABLESAMPLE",
+	"TASK",
+	"TEMP",
+	"TEMPORARY",
+	"THEN",
+	"TO",
+	"TRANSIENT",
+	"TRIGGER",
+	"TRUE",
+	"TRY_CAST",
+	"TYPE",
+	"UNION",
+	"UNIQUE",
+	"UNMATCHED",
+	"UPDATE",
+	"USING",
+	"VALUES",
+	"VIEW",
+	"VOLATILE",
+	"WAREHOUSE",
+	"WHEN",
+	"WHENEVER",
+	"WHERE",
+	"WITH",
+}
+
+var DataTypes = []string{
+	"ARRAY",
+	"BIGINT",
+	"BINARY",
+	"BOOLEAN",
+	"BYTEINT",
+	"CHAR",
+	"CHARACTER",
+	"DATE",
+	"DATETIME",
+	"DEC",
+	"DECIMAL",
+	"DOUBLE",
+	"FLOAT",
+	"FLOAT4",
+	"FLOAT8",
+	"GEOGRAPHY",
+	"GEOMETRY",
+	"INT",
+	"INTEGER",
+	"NCHAR",
+	"NUMBER",
+	"NUMERIC",
+	"NVARCHAR",
+	"NVARCHAR2",
+	"OBJECT",
+	"REAL",
+	"SMALLINT",
+	"STRING",
+	"TEXT",
+	"TIME",
+	"TIMESTAMP",
+	"TIMESTAMP_LTZ",
+	"TIMESTAMP_NTZ",
+	"TIMESTAMP_TZ",
+	"TINYINT",
+	"VARBINARY",
+	"VARCHAR",
+	"VARIANT",
+	"VECTOR",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ADD_MONTHS",
+	"ANY_VALUE",
+	"APPROX_COUNT_DISTINCT",
+	"APPROX_PERCENTILE",
+	"ARRAYS_OVERLAP",
+	"ARRAY_AGG",
+	"ARRAY_APPEND",
+	"ARRAY_CAT",
+	"ARRAY_COMPACT",
+	"ARRAY_CONSTRUCT",
+	"ARRAY_CONSTRUCT_COMPACT",
+	"ARRAY_CONTAINS",
+	"ARRAY_DISTINCT",
+	"ARRAY_INSERT",
+	"ARRAY_INTERSECTION",
+	"ARRAY_POSITION",
+	"ARRAY_PREPEND",
+	"ARRAY_SIZE",
+	"ARRAY_SLICE",
+	"ARRAY_SORT",
+	"ARRAY_TO_STRING",
+	"ARRAY_UNION_AGG",
+	"ARRAY_UNIQUE_AGG",
+	"ASCII",
+	"ASIN",
+	"AS_ARRAY",
+	"AS_OBJECT",
+	"AS_VARCHAR",
+	"ATAN",
+	"ATAN2",
+	"AVG",
+	"BASE64_DECODE_STRING",
+	"BASE64_ENCODE",
+	"BITAND",
+	"BITOR",
+	"BITXOR",
+	"BOOLAND_AGG",
+	"BOOLOR_AGG",
+	"CAST",
+	"CEIL",
+	"CHARINDEX",
+	"CHECK_JSON",
+	"CLASSIFIER",
+	"COALESCE",
+	"CONCAT",
+	"CONCAT_WS",
+	"CONTAINS",
+	"CONVERT_TIMEZONE",
+	"CORR",
+	"COS",
+	"COUNT",
+	"COUNT_IF",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"CUME_DIST",
+	"CURRENT_ACCOUNT",
+	"CURRENT_DATABASE",
+	"CURRENT_REGION",
+	"CURRENT_ROLE",
+	"CURRENT_SCHEMA",
+	"CURRENT_SESSION",
+	"CURRENT_STATEMENT",
+	"CURRENT_WAREHOUSE",
+	"DATEADD",
+	"DATEDIFF",
+	"DATE_FROM_PARTS",
+	"DATE_PART",
+	"DATE_TRUNC",
+	"DAYNAME",
+	"DAYOFWEEK",
+	"DAYOFYEAR",
+	"DECODE",
+	"DENSE_RANK",
+	"DIV0",
+	"DIV0NULL",
+	"EDITDISTANCE",
+	"ENDSWITH",
+	"EQUAL_NULL",
+	"EXP",
+	"EXTRACT",
+	"FIRST_VALUE",
+	"FLATTEN",
+	"FLOOR",
+	"GENERATOR",
+	"GET",
+	"GET_PATH",
+	"GREATEST",
+	"HASH",
+	"HASH_AGG",
+	"HEX_ENCODE",
+	"HOUR",
+	"IFF",
+	"IFNULL",
+	"INITCAP",
+	"IS_ARRAY",
+	"IS_NULL_VALUE",
+	"IS_OBJECT",
+	"JSON_EXTRACT_PATH_TEXT",
+	"LAG",
+	"LAST_DAY",
+	"LAST_VALUE",
+	"LEAD",
+	"LEAST",
+	"LEFT",
+	"LENGTH",
+	"LISTAGG",
+	"LN",
+	"LOG",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MATCH_NUMBER",
+	"MAX",
+	"MAX_BY",
+	"MD5",
+	"MD5_HEX",
+	"MEDIAN",
+	"MIN",
+	"MINUTE",
+	"MIN_BY",
+	"MOD",
+	"MODE",
+	"MONTH",
+	"MONTHNAME",
+	"MONTHS_BETWEEN",
+	"NEXT",
+	"NEXT_DAY",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"NULLIFZERO",
+	"NVL",
+	"NVL2",
+	"OBJECT_AGG",
+	"OBJECT_CONSTRUCT",
+	"OBJECT_CONSTRUCT_KEEP_NULL",
+	"OBJECT_DELETE",
+	"OBJECT_INSERT",
+	"OBJECT_KEYS",
+	"OBJECT_PICK",
+	"PARSE_JSON",
+	"PARSE_XML",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"PERCENT_RANK",
+	"POSITION",
+	"POW",
+	"POWER",
+	"PREV",
+	"PREVIOUS_DAY",
+	"QUARTER",
+	"RANDOM",
+	"RANK",
+	"RATIO_TO_REPORT",
+	"REGEXP_COUNT",
+	"REGEXP_INSTR",
+	"REGEXP_LIKE",
+	"REGEXP_REPLACE",
+	"REGEXP_SUBSTR",
+	"REPEAT",
+	"REPLACE",
+	"RESULT_SCAN",
+	"REVERSE",
+	"RIGHT",
+	"ROUND",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SECOND",
+	"SEQ1",
+	"SEQ2",
+	"SEQ4",
+	"SEQ8",
+	"SHA1",
+	"SHA2",
+	"SIGN",
+	"SIN",
+	"SPLIT",
+	"SPLIT_PART",
+	"SPLIT_TO_TABLE",
+	"SQRT",
+	"SQUARE",
+	"STARTSWITH",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"STRIP_NULL_VALUE",
+	"STRTOK",
+	"STRTOK_TO_ARRAY",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUM",
+	"SYSDATE",
+	"TAN",
+	"TIMEADD",
+	"TIMEDIFF",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"TIMESTAMP_FROM_PARTS",
+	"TIME_FROM_PARTS",
+	"TIME_SLICE",
+	"TO_ARRAY",
+	"TO_BOOLEAN",
+	"TO_CHAR",
+	"TO_DATE",
+	"TO_DECIMAL",
+	"TO_DOUBLE",
+	"TO_JSON",
+	"TO_NUMBER",
+	"TO_NUMERIC",
+	"TO_OBJECT",
+	"TO_TIME",
+	"TO_TIMESTAMP",
+	"TO_TIMESTAMP_LTZ",
+	"TO_TIMESTAMP_NTZ",
+	"TO_TIMESTAMP_TZ",
+	"TO_VARCHAR",
+	"TO_VARIANT",
+	"TRANSLATE",
+	"TRIM",
+	"TRUNC",
+	"TRUNCATE",
+	"TRY_PARSE_JSON",
+	"TRY_TO_BOOLEAN",
+	"TRY_TO_DATE",
+	"TRY_TO_DECIMAL",
+	"TRY_TO_NUMBER",
+	"TRY_TO_TIMESTAMP",
+	"TYPEOF",
+	"UNIFORM",
+	"UPPER",
+	"UUID_STRING",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"WEEK",
+	"WEEKOFYEAR",
+	"YEAR",
+	"ZEROIFNULL",
+}
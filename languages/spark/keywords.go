@@ -0,0 +1,559 @@
+package spark
+
+var Keywords = []string{
+	"ADD",
+	"AFTER",
+	"ALL",
+	"ALTER",
+	"ANALYZE",
+	"AND",
+	"ANTI",
+	"ANY",
+	"ARCHIVE",
+	"AS",
+	"ASC",
+	"AT",
+	"AUTHORIZATION",
+	"BETWEEN",
+	"BOTH",
+	"BUCKET",
+	"BUCKETS",
+	"BY",
+	"CACHE",
+	"CASCADE",
+	"CHANGE",
+	"CHECK",
+	"CLEAR",
+	"CLUSTER",
+	"CLUSTERED",
+	"CODEGEN",
+	"COLLATE",
+	"COLLECTION",
+	"COLUMN",
+	"COLUMNS",
+	"COMMENT",
+	"COMMIT",
+	"COMPACT",
+	"COMPACTIONS",
+	"COMPUTE",
+	"CONCATENATE",
+	"CONSTRAINT",
+	"COST",
+	"CROSS",
+	"CUBE",
+	"CURRENT",
+	"CURRENT_TIME",
+	"CURRENT_USER",
+	"DATA",
+	"DATABASE",
+	"DATABASES",
+	"DAY",
+	"DBPROPERTIES",
+	"DEFINED",
+	"DELETE",
+	"DELIMITED",
+	"DESC",
+	"DESCRIBE",
+	"DFS",
+	"DIRECTORIES",
+	"DIRECTORY",
+	"DISTINCT",
+	"DISTRIBUTE",
+	"DIV",
+	"DROP",
+	"ELSE",
+	"END",
+	"ESCAPE",
+	"ESCAPED",
+	"EXCEPT",
+	"EXCHANGE",
+	"EXISTS",
+	"EXPLAIN",
+	"EXPORT",
+	"EXTENDED",
+	"EXTERNAL",
+	"FALSE",
+	"FETCH",
+	"FIELDS",
+	"FILEFORMAT",
+	"FILTER",
+	"FIRST",
+	"FOLLOWING",
+	"FOR",
+	"FOREIGN",
+	"FORMAT",
+	"FORMATTED",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"FUNCTIONS",
+	"GLOBAL",
+	"GRANT",
+	"GROUP",
+	"GROUPING",
+	"HAVING",
+	"HOUR",
+	"IF",
+	"IGNORE",
+	"ILIKE",
+	"IMPORT",
+	"IN",
+	"INDEX",
+	"INDEXES",
+	"INNER",
+	"INPATH",
+	"INPUTFORMAT",
+	"INTERSECT",
+	"INTERVAL",
+	"INTO",
+	"IS",
+	"ITEMS",
+	"JOIN",
+	"KEYS",
+	"LAST",
+	"LATERAL",
+	"LAZY",
+	"LEADING",
+	"LEFT",
+	"LIKE",
+	"LIMIT",
+	"LINES",
+	"LOAD",
+	"LOCAL",
+	"LOCATION",
+	"LOCK",
+	"LOCKS",
+	"LOGICAL",
+	"MACRO",
+	"MATCHED",
+	"MERGE",
+	"MINUTE",
+	"MONTH",
+	"MSCK",
+	"NAMESPACE",
+	"NAMESPACES",
+	"NATURAL",
+	"NO",
+	"NOT",
+	"NULL",
+	"NULLS",
+	"OF",
+	"ON",
+	"ONLY",
+	"OPTION",
+	"OPTIONS",
+	"OR",
+	"ORDER",
+	"OUT",
+	"OUTER",
+	"OUTPUTFORMAT",
+	"OVER",
+	"OVERLAPS",
+	"OVERLAY",
+	"OVERWRITE",
+	"PARTITION",
+	"PARTITIONED",
+	"PARTITIONS",
+	"PERCENT",
+	"PIVOT",
+	"PLACING",
+	"PRECEDING",
+	"PRIMARY",
+	"PRINCIPALS",
+	"PROPERTIES",
+	"PURGE",
+	"QUERY",
+	"RANGE",
+	"RECORDREADER",
+	"RECORDWRITER",
+	"RECOVER",
+	"REDUCE",
+	"REFERENCES",
+	"REFRESH",
+	"RENAME",
+	"REPAIR",
+	"REPLACE",
+	"RESET",
+	"RESPECT",
+	"RESTRICT",
+	"REVOKE",
+	"RIGHT",
+	"RLIKE",
+	"ROLE",
+	"ROLES",
+	"ROLLBACK",
+	"ROLLUP",
+	"ROW",
+	"ROWS",
+	"SCHEMA",
+	"SECOND",
+	"SEMI",
+	"SEPARATED",
+	"SERDE",
+	"SERDEPROPERTIES",
+	"SESSION_USER",
+	"SET",
+	"SETS",
+	"SHOW",
+	"SKEWED",
+	"SOME",
+	"SORT",
+	"SORTED",
+	"START",
+	"STATISTICS",
+	"STORED",
+	"STRATIFY",
+	"SYNC",
+	"TABLE",
+	"TABLES",
+	"TABLESAMPLE",
+	"TBLPROPERTIES",
+	"TEMP",
+	"TEMPORARY",
+	"TERMINATED",
+	"THEN",
+	"TIME",
+	"TO",
+	"TOUCH",
+	"TRAILING",
+	"TRANSACTION",
+	"TRANSACTIONS",
+	"TRANSFORM",
+	"TRUE",
+	"TRUNCATE",
+	"UNARCHIVE",
+	"UNBOUNDED",
+	"UNCACHE",
+	"UNION",
+	"UNIQUE",
+	"UNKNOWN",
+	"UNLOCK",
+	"UNSET",
+	"UPDATE",
+	"USE",
+	"USER",
+	"USING",
+	"VALUES",
+	"VIEW",
+	"VIEWS",
+	"WHEN",
+	"WHERE",
+	"WINDOW",
+	"WITH",
+	"YEAR",
+	"ZONE",
+}
+
+var DataTypes = []string{
+	"ARRAY",
+	"BIGINT",
+	"BINARY",
+	"BOOLEAN",
+	"BYTE",
+	"CHAR",
+	"DATE",
+	"DEC",
+	"DECIMAL",
+	"DOUBLE",
+	"FLOAT",
+	"INT",
+	"INTEGER",
+	"LONG",
+	"MAP",
+	"NUMERIC",
+	"REAL",
+	"SHORT",
+	"SMALLINT",
+	"STRING",
+	"STRUCT",
+	"TIMESTAMP",
+	"TIMESTAMP_LTZ",
+	"TIMESTAMP_NTZ",
+	"TINYINT",
+	"VARCHAR",
+	"VOID",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ACOSH",
+	"AGGREGATE",
+	"ANY_VALUE",
+	"APPROX_COUNT_DISTINCT",
+	"APPROX_PERCENTILE",
+	"ARRAYS_OVERLAP",
+	"ARRAYS_ZIP",
+	"ARRAY_AGG",
+	"ARRAY_CONTAINS",
+	"ARRAY_DISTINCT",
+	"ARRAY_EXCEPT",
+	"ARRAY_INTERSECT",
+	"ARRAY_JOIN",
+	"ARRAY_MAX",
+	"ARRAY_MIN",
+	"ARRAY_POSITION",
+	"ARRAY_REMOVE",
+	"ARRAY_REPEAT",
+	"ARRAY_SORT",
+	"ARRAY_UNION",
+	"ASCII",
+	"ASIN",
+	"ASINH",
+	"ASSERT_TRUE",
+	"ATAN",
+	"ATAN2",
+	"ATANH",
+	"AVG",
+	"BASE64",
+	"BIN",
+	"BIT_AND",
+	"BIT_COUNT",
+	"BIT_LENGTH",
+	"BIT_OR",
+	"BIT_XOR",
+	"BOOL_AND",
+	"BOOL_OR",
+	"BROUND",
+	"CARDINALITY",
+	"CAST",
+	"CBRT",
+	"CEIL",
+	"CEILING",
+	"CHARACTER_LENGTH",
+	"CHAR_LENGTH",
+	"CHR",
+	"COALESCE",
+	"COLLECT_LIST",
+	"COLLECT_SET",
+	"CONCAT",
+	"CONCAT_WS",
+	"CONV",
+	"CORR",
+	"COS",
+	"COSH",
+	"COT",
+	"COUNT",
+	"COUNT_IF",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"CRC32",
+	"CUME_DIST",
+	"CURRENT_CATALOG",
+	"CURRENT_DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_TIMEZONE",
+	"DATEADD",
+	"DATEDIFF",
+	"DATE_ADD",
+	"DATE_DIFF",
+	"DATE_FORMAT",
+	"DATE_FROM_UNIX_DATE",
+	"DATE_PART",
+	"DATE_SUB",
+	"DATE_TRUNC",
+	"DAYOFMONTH",
+	"DAYOFWEEK",
+	"DAYOFYEAR",
+	"DECODE",
+	"DEGREES",
+	"DENSE_RANK",
+	"ELEMENT_AT",
+	"ELT",
+	"ENCODE",
+	"EVERY",
+	"EXP",
+	"EXPLODE",
+	"EXPLODE_OUTER",
+	"EXPM1",
+	"EXTRACT",
+	"FACTORIAL",
+	"FIND_IN_SET",
+	"FIRST_VALUE",
+	"FLATTEN",
+	"FLOOR",
+	"FORALL",
+	"FORMAT_NUMBER",
+	"FORMAT_STRING",
+	"FROM_JSON",
+	"FROM_UNIXTIME",
+	"FROM_UTC_TIMESTAMP",
+	"GET_JSON_OBJECT",
+	"GREATEST",
+	"GROUPING",
+	"GROUPING_ID",
+	"HASH",
+	"HEX",
+	"HOUR",
+	"HYPOT",
+	"IF",
+	"IFNULL",
+	"INITCAP",
+	"INLINE",
+	"INLINE_OUTER",
+	"INPUT_FILE_NAME",
+	"INSTR",
+	"ISNAN",
+	"ISNOTNULL",
+	"ISNULL",
+	"JSON_ARRAY_LENGTH",
+	"JSON_OBJECT_KEYS",
+	"JSON_TUPLE",
+	"KURTOSIS",
+	"LAG",
+	"LAST_DAY",
+	"LAST_VALUE",
+	"LCASE",
+	"LEAD",
+	"LEAST",
+	"LENGTH",
+	"LEVENSHTEIN",
+	"LN",
+	"LOCATE",
+	"LOG",
+	"LOG10",
+	"LOG1P",
+	"LOG2",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MAKE_DATE",
+	"MAKE_INTERVAL",
+	"MAKE_TIMESTAMP",
+	"MAP_CONCAT",
+	"MAP_ENTRIES",
+	"MAP_FILTER",
+	"MAP_FROM_ARRAYS",
+	"MAP_FROM_ENTRIES",
+	"MAP_KEYS",
+	"MAP_VALUES",
+	"MAP_ZIP_WITH",
+	"MAX",
+	"MAX_BY",
+	"MD5",
+	"MEAN",
+	"MIN",
+	"MINUTE",
+	"MIN_BY",
+	"MOD",
+	"MONOTONICALLY_INCREASING_ID",
+	"MONTH",
+	"MONTHS_BETWEEN",
+	"NAMED_STRUCT",
+	"NANVL",
+	"NEGATIVE",
+	"NEXT_DAY",
+	"NOW",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"NVL",
+	"NVL2",
+	"OCTET_LENGTH",
+	"OVERLAY",
+	"PARSE_URL",
+	"PERCENTILE",
+	"PERCENTILE_APPROX",
+	"PERCENT_RANK",
+	"PI",
+	"PMOD",
+	"POSEXPLODE",
+	"POSEXPLODE_OUTER",
+	"POSITION",
+	"POSITIVE",
+	"POW",
+	"POWER",
+	"PRINTF",
+	"QUARTER",
+	"RADIANS",
+	"RAISE_ERROR",
+	"RAND",
+	"RANDN",
+	"RANK",
+	"REFLECT",
+	"REGEXP_EXTRACT",
+	"REGEXP_EXTRACT_ALL",
+	"REGEXP_REPLACE",
+	"REPEAT",
+	"REPLACE",
+	"REVERSE",
+	"RINT",
+	"ROUND",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SCHEMA_OF_JSON",
+	"SECOND",
+	"SENTENCES",
+	"SEQUENCE",
+	"SHA",
+	"SHA1",
+	"SHA2",
+	"SHIFTLEFT",
+	"SHIFTRIGHT",
+	"SHUFFLE",
+	"SIGN",
+	"SIGNUM",
+	"SIN",
+	"SINH",
+	"SIZE",
+	"SKEWNESS",
+	"SLICE",
+	"SORT_ARRAY",
+	"SOUNDEX",
+	"SPACE",
+	"SPARK_PARTITION_ID",
+	"SPLIT",
+	"SPLIT_PART",
+	"SQRT",
+	"STACK",
+	"STARTSWITH",
+	"STD",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"STR_TO_MAP",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUBSTRING_INDEX",
+	"SUM",
+	"TAN",
+	"TANH",
+	"TIMESTAMP_MICROS",
+	"TIMESTAMP_MILLIS",
+	"TIMESTAMP_SECONDS",
+	"TO_CSV",
+	"TO_DATE",
+	"TO_JSON",
+	"TO_TIMESTAMP",
+	"TO_UNIX_TIMESTAMP",
+	"TO_UTC_TIMESTAMP",
+	"TRANSFORM_KEYS",
+	"TRANSFORM_VALUES",
+	"TRANSLATE",
+	"TRIM",
+	"TRUNC",
+	"TRY_CAST",
+	"TYPEOF",
+	"UCASE",
+	"UNBASE64",
+	"UNHEX",
+	"UNIX_DATE",
+	"UNIX_MICROS",
+	"UNIX_MILLIS",
+	"UNIX_SECONDS",
+	"UNIX_TIMESTAMP",
+	"UPPER",
+	"UUID",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"WEEKDAY",
+	"WEEKOFYEAR",
+	"WIDTH_BUCKET",
+	"XPATH",
+	"XXHASH64",
+	"YEAR",
+	"ZIP_WITH",
+}
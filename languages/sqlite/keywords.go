@@ -0,0 +1,305 @@
+package sqlite
+
+var Keywords = []string{
+	"ABORT",
+	"ACTION",
+	"ADD",
+	"AFTER",
+	"ALL",
+	"ALTER",
+	"ALWAYS",
+	"ANALYZE",
+	"AND",
+	"AS",
+	"ASC",
+	"ATTACH",
+	"AUTOINCREMENT",
+	"BEFORE",
+	"BEGIN",
+	"BETWEEN",
+	"BY",
+	"CASCADE",
+	"CASE",
+	"CAST",
+	"CHECK",
+	"COLLATE",
+	"COLUMN",
+	"COMMIT",
+	"CONFLICT",
+	"CONSTRAINT",
+	"CREATE",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"DEFAULT",
+	"DEFERRABLE",
+	"DEFERRED",
+	"DELETE",
+	"DESC",
+	"DETACH",
+	"DISTINCT",
+	"DO",
+	"DROP",
+	"EACH",
+	"ELSE",
+	"END",
+	"ESCAPE",
+	"EXCEPT",
+	"EXCLUDE",
+	"EXCLUSIVE",
+	"EXISTS",
+	"EXPLAIN",
+	"FAIL",
+	"FILTER",
+	"FIRST",
+	"FOLLOWING",
+	"FOR",
+	"FOREIGN",
+	"FROM",
+	"FULL",
+	"GENERATED",
+	"GLOB",
+	"GROUP",
+	"GROUPS",
+	"HAVING",
+	"IF",
+	"IGNORE",
+	"IMMEDIATE",
+	"IN",
+	"INDEX",
+	"INDEXED",
+	"INITIALLY",
+	"INNER",
+	"INSERT",
+	"INSTEAD",
+	"INTERSECT",
+	"INTO",
+	"IS",
+	"ISNULL",
+	"JOIN",
+	"KEY",
+	"LAST",
+	"LEFT",
+	"LIKE",
+	"LIMIT",
+	"MATCH",
+	"MATERIALIZED",
+	"NATURAL",
+	"NO",
+	"NOT",
+	"NOTHING",
+	"NOTNULL",
+	"NULL",
+	"NULLS",
+	"OF",
+	"OFFSET",
+	"ON",
+	"OR",
+	"ORDER",
+	"OTHERS",
+	"OUTER",
+	"OVER",
+	"PARTITION",
+	"PLAN",
+	"PRAGMA",
+	"PRECEDING",
+	"PRIMARY",
+	"QUERY",
+	"RAISE",
+	"RANGE",
+	"RECURSIVE",
+	"REFERENCES",
+	"REGEXP",
+	"REINDEX",
+	"RELEASE",
+	"RENAME",
+	"REPLACE",
+	"RESTRICT",
+	"RETURNING",
+	"RIGHT",
+	"ROLLBACK",
+	"ROW",
+	"ROWID",
+	"ROWS",
+	"SAVEPOINT",
+	"SELECT",
+	"SET",
+	"STRICT",
+	"TABLE",
+	"TEMP",
+	"TEMPORARY",
+	"THEN",
+	"TIES",
+	"TO",
+	"TRANSACTION",
+	"TRIGGER",
+	"UNBOUNDED",
+	"UNION",
+	"UNIQUE",
+	"UPDATE",
+	"USING",
+	"VACUUM",
+	"VALUES",
+	"VIEW",
+	"VIRTUAL",
+	"WHEN",
+	"WHERE",
+	"WINDOW",
+	"WITH",
+	"WITHOUT",
+}
+
+var DataTypes = []string{
+	"ANY",
+	"ARRAY",
+	"BIGINT",
+	"BLOB",
+	"BOOLEAN",
+	"CHAR",
+	"CHARACTER",
+	"CLOB",
+	"DECIMAL",
+	"DOUBLE",
+	"FLOAT",
+	"INT",
+	"INT2",
+	"INT8",
+	"INTEGER",
+	"MEDIUMINT",
+	"NATIVE",
+	"NCHAR",
+	"NUMERIC",
+	"NVARCHAR",
+	"PRECISION",
+	"REAL",
+	"SMALLINT",
+	"TEXT",
+	"TINYINT",
+	"VARCHAR",
+	"VARYING",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ACOSH",
+	"ASIN",
+	"ASINH",
+	"ATAN",
+	"ATAN2",
+	"ATANH",
+	"AVG",
+	"CAST",
+	"CEIL",
+	"CEILING",
+	"CHANGES",
+	"CHAR",
+	"COALESCE",
+	"CONCAT",
+	"CONCAT_WS",
+	"COS",
+	"COSH",
+	"COUNT",
+	"CUME_DIST",
+	"DATE",
+	"DATETIME",
+	"DEGREES",
+	"DENSE_RANK",
+	"EXP",
+	"FIRST_VALUE",
+	"FLOOR",
+	"FORMAT",
+	"GLOB",
+	"GROUP_CONCAT",
+	"HEX",
+	"IFNULL",
+	"IIF",
+	"INSTR",
+	"JSON",
+	"JSONB",
+	"JSON_ARRAY",
+	"JSON_ARRAY_LENGTH",
+	"JSON_EACH",
+	"JSON_ERROR_POSITION",
+	"JSON_EXTRACT",
+	"JSON_GROUP_ARRAY",
+	"JSON_GROUP_OBJECT",
+	"JSON_INSERT",
+	"JSON_OBJECT",
+	"JSON_PATCH",
+	"JSON_QUOTE",
+	"JSON_REMOVE",
+	"JSON_REPLACE",
+	"JSON_SET",
+	"JSON_TREE",
+	"JSON_TYPE",
+	"JSON_VALID",
+	"JULIANDAY",
+	"LAG",
+	"LAST_INSERT_ROWID",
+	"LAST_VALUE",
+	"LEAD",
+	"LENGTH",
+	"LIKE",
+	"LIKELIHOOD",
+	"LIKELY",
+	"LN",
+	"LOAD_EXTENSION",
+	"LOG",
+	"LOG10",
+	"LOG2",
+	"LOWER",
+	"LTRIM",
+	"MAX",
+	"MIN",
+	"MOD",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"OCTET_LENGTH",
+	"PERCENT_RANK",
+	"PI",
+	"POW",
+	"POWER",
+	"PRINTF",
+	"QUOTE",
+	"RADIANS",
+	"RANDOM",
+	"RANDOMBLOB",
+	"RANK",
+	"REPLACE",
+	"ROUND",
+	"ROW_NUMBER",
+	"RTRIM",
+	"SIGN",
+	"SIN",
+	"SINH",
+	"SOUNDEX",
+	"SQLITE_COMPILEOPTION_GET",
+	"SQLITE_COMPILEOPTION_USED",
+	"SQLITE_OFFSET",
+	"SQLITE_SOURCE_ID",
+	"SQLITE_VERSION",
+	"SQRT",
+	"STRFTIME",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUM",
+	"TAN",
+	"TANH",
+	"TIME",
+	"TIMEDIFF",
+	"TOTAL",
+	"TOTAL_CHANGES",
+	"TRIM",
+	"TRUNC",
+	"TYPEOF",
+	"UNICODE",
+	"UNIXEPOCH",
+	"UNLIKELY",
+	"UPPER",
+	"ZEROBLOB",
+}
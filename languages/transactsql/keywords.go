@@ -0,0 +1,418 @@
+package transactsql
+
+var Keywords = []string{
+	"ADD",
+	"AFTER",
+	"ALL",
+	"ALTER",
+	"AND",
+	"ANY",
+	"APPLY",
+	"AS",
+	"ASC",
+	"AUTHORIZATION",
+	"BACKUP",
+	"BEGIN",
+	"BETWEEN",
+	"BREAK",
+	"BROWSE",
+	"BULK",
+	"BY",
+	"CASCADE",
+	"CASE",
+	"CATCH",
+	"CHECK",
+	"CHECKPOINT",
+	"CLOSE",
+	"CLUSTERED",
+	"COALESCE",
+	"COLLATE",
+	"COLUMN",
+	"COMMIT",
+	"COMPUTE",
+	"CONSTRAINT",
+	"CONTAINS",
+	"CONTAINSTABLE",
+	"CONTINUE",
+	"CONVERT",
+	"CREATE",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
+	"CURSOR",
+	"DATABASE",
+	"DBCC",
+	"DEALLOCATE",
+	"DECLARE",
+	"DEFAULT",
+	"DELETE",
+	"DENY",
+	"DESC",
+	"DISK",
+	"DISTINCT",
+	"DISTRIBUTED",
+	"DOUBLE",
+	"DROP",
+	"DUMP",
+	"ELSE",
+	"END",
+	"ERRLVL",
+	"ESCAPE",
+	"EXCEPT",
+	"EXEC",
+	"EXECUTE",
+	"EXISTS",
+	"EXIT",
+	"EXTERNAL",
+	"FETCH",
+	"FILE",
+	"FILLFACTOR",
+	"FIRST",
+	"FOR",
+	"FOREIGN",
+	"FREETEXT",
+	"FREETEXTTABLE",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GOTO",
+	"GRANT",
+	"GROUP",
+	"HAVING",
+	"HOLDLOCK",
+	"IDENTITY",
+	"IDENTITYCOL",
+	"IDENTITY_INSERT",
+	"IF",
+	"IN",
+	"INCLUDE",
+	"INDEX",
+	"INNER",
+	"INSERT",
+	"INSTEAD",
+	"INTERSECT",
+	"INTO",
+	"IS",
+	"JOIN",
+	"KEY",
+	"KILL",
+	"LAST",
+	"LEFT",
+	"LIKE",
+	"LINENO",
+	"LOAD",
+	"MATCHED",
+	"MERGE",
+	"NATIONAL",
+	"NEXT",
+	"NOCHECK",
+	"NOCOUNT",
+	"NOLOCK",
+	"NONCLUSTERED",
+	"NOT",
+	"NULL",
+	"NULLIF",
+	"OF",
+	"OFF",
+	"OFFSET",
+	"OFFSETS",
+	"ON",
+	"ONLY",
+	"OPEN",
+	"OPENDATASOURCE",
+	"OPENQUERY",
+	"OPENROWSET",
+	"OPENXML",
+	"OPTION",
+	"OR",
+	"ORDER",
+	"OUT",
+	"OUTER",
+	"OUTPUT",
+	"OVER",
+	"PARTITION",
+	"PERCENT",
+	"PIVOT",
+	"PLAN",
+	"PRECISION",
+	"PRIMARY",
+	"PRINT",
+	"PROC",
+	"PROCEDURE",
+	"PUBLIC",
+	"RAISERROR",
+	"READ",
+	"READONLY",
+	"READTEXT",
+	"RECONFIGURE",
+	"REFERENCES",
+	"REPLICATION",
+	"RESTORE",
+	"RESTRICT",
+	"RETURN",
+	"RETURNS",
+	"REVERT",
+	"REVOKE",
+	"RIGHT",
+	"ROLLBACK",
+	"ROWCOUNT",
+	"ROWGUIDCOL",
+	"ROWS",
+	"RULE",
+	"SAVE",
+	"SCHEMA",
+	"SECURITYAUDIT",
+	"SELECT",
+	"SEMANTICKEYPHRASETABLE",
+	"SEMANTICSIMILARITYDETAILSTABLE",
+	"SEMANTICSIMILARITYTABLE",
+	"SESSION_USER",
+	"SET",
+	"SETUSER",
+	"SHUTDOWN",
+	"SOME",
+	"SOURCE",
+	"STATISTICS",
+	"SYSTEM_USER",
+	"TABLE",
+	"TABLESAMPLE",
+	"TARGET",
+	"TEXTSIZE",
+	"THEN",
+	"TIES",
+	"TO",
+	"TOP",
+	"TRAN",
+	"TRANSACTION",
+	"TRIGGER",
+	"TRUNCATE",
+	"TRY",
+	"TRY_CONVERT",
+	"TSEQUAL",
+	"UNION",
+	"UNIQUE",
+	"UNPIVOT",
+	"UPDATE",
+	"UPDATETEXT",
+	"USE",
+	"USER",
+	"VALUES",
+	"VARYING",
+	"VIEW",
+	"WAITFOR",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WITH",
+	"WITHIN",
+	"WRITETEXT",
+	"XACT_ABORT",
+}
+
+var DataTypes = []string{
+	"BIGINT",
+	"BINARY",
+	"BIT",
+	"CHAR",
+	"CHARACTER",
+	"CURSOR",
+	"DATE",
+	"DATETIME",
+	"DATETIME2",
+	"DATETIMEOFFSET",
+	"DEC",
+	"DECIMAL",
+	"FLOAT",
+	"GEOGRAPHY",
+	"GEOMETRY",
+	"HIERARCHYID",
+	"IMAGE",
+	"INT",
+	"INTEGER",
+	"MONEY",
+	"NCHAR",
+	"NTEXT",
+	"NUMERIC",
+	"NVARCHAR",
+	"REAL",
+	"ROWVERSION",
+	"SMALLDATETIME",
+	"SMALLINT",
+	"SMALLMONEY",
+	"SQL_VARIANT",
+	"TABLE",
+	"TEXT",
+	"TIME",
+	"TIMESTAMP",
+	"TINYINT",
+	"UNIQUEIDENTIFIER",
+	"VARBINARY",
+	"VARCHAR",
+	"XML",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"APPROX_COUNT_DISTINCT",
+	"APP_NAME",
+	"ASCII",
+	"ASIN",
+	"ATAN",
+	"ATN2",
+	"AVG",
+	"BINARY_CHECKSUM",
+	"CAST",
+	"CEILING",
+	"CHARINDEX",
+	"CHECKSUM",
+	"CHECKSUM_AGG",
+	"CHOOSE",
+	"COALESCE",
+	"COLUMNPROPERTY",
+	"COL_LENGTH",
+	"COMPRESS",
+	"CONCAT",
+	"CONCAT_WS",
+	"CONTAINSTABLE",
+	"CONVERT",
+	"COS",
+	"COT",
+	"COUNT",
+	"COUNT_BIG",
+	"CUME_DIST",
+	"CURRENT_TIMEZONE",
+	"DATEADD",
+	"DATEDIFF",
+	"DATEDIFF_BIG",
+	"DATEFROMPARTS",
+	"DATENAME",
+	"DATEPART",
+	"DATETIME2FROMPARTS",
+	"DATETIMEFROMPARTS",
+	"DATETIMEOFFSETFROMPARTS",
+	"DATETRUNC",
+	"DATE_BUCKET",
+	"DAY",
+	"DB_ID",
+	"DB_NAME",
+	"DECOMPRESS",
+	"DEGREES",
+	"DENSE_RANK",
+	"DIFFERENCE",
+	"EOMONTH",
+	"ERROR_LINE",
+	"ERROR_MESSAGE",
+	"ERROR_NUMBER",
+	"ERROR_PROCEDURE",
+	"ERROR_SEVERITY",
+	"ERROR_STATE",
+	"EXP",
+	"FIRST_VALUE",
+	"FLOOR",
+	"FORMAT",
+	"FREETEXTTABLE",
+	"GETDATE",
+	"GETUTCDATE",
+	"GREATEST",
+	"GROUPING",
+	"GROUPING_ID",
+	"HASHBYTES",
+	"HOST_NAME",
+	"IIF",
+	"ISDATE",
+	"ISJSON",
+	"ISNULL",
+	"JSON_ARRAY",
+	"JSON_MODIFY",
+	"JSON_OBJECT",
+	"JSON_PATH_EXISTS",
+	"JSON_QUERY",
+	"JSON_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"LEAST",
+	"LEFT",
+	"LEN",
+	"LOG",
+	"LOG10",
+	"LOWER",
+	"LTRIM",
+	"MAX",
+	"MIN",
+	"MONTH",
+	"NEWID",
+	"NEWSEQUENTIALID",
+	"NTILE",
+	"NULLIF",
+	"OBJECTPROPERTY",
+	"OBJECT_ID",
+	"OBJECT_NAME",
+	"OPENDATASOURCE",
+	"OPENJSON",
+	"OPENQUERY",
+	"OPENROWSET",
+	"OPENXML",
+	"PARSE",
+	"PATINDEX",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"PERCENT_RANK",
+	"PI",
+	"POWER",
+	"QUOTENAME",
+	"RADIANS",
+	"RAND",
+	"RANK",
+	"REPLACE",
+	"REPLICATE",
+	"REVERSE",
+	"RIGHT",
+	"ROUND",
+	"ROW_NUMBER",
+	"RTRIM",
+	"SCHEMA_ID",
+	"SCHEMA_NAME",
+	"SCOPE_IDENTITY",
+	"SIGN",
+	"SIN",
+	"SMALLDATETIMEFROMPARTS",
+	"SOUNDEX",
+	"SPACE",
+	"SQRT",
+	"SQUARE",
+	"STDEV",
+	"STDEVP",
+	"STR",
+	"STRING_AGG",
+	"STRING_ESCAPE",
+	"STRING_SPLIT",
+	"STUFF",
+	"SUBSTRING",
+	"SUM",
+	"SUSER_NAME",
+	"SUSER_SNAME",
+	"SWITCHOFFSET",
+	"SYSDATETIME",
+	"SYSDATETIMEOFFSET",
+	"SYSUTCDATETIME",
+	"TAN",
+	"TIMEFROMPARTS",
+	"TODATETIMEOFFSET",
+	"TRANSLATE",
+	"TRIM",
+	"TRY_CAST",
+	"TRY_CONVERT",
+	"TRY_PARSE",
+	"UNICODE",
+	"UPPER",
+	"USER_NAME",
+	"VAR",
+	"VARP",
+	"XACT_STATE",
+	"YEAR",
+}
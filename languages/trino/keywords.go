@@ -0,0 +1,447 @@
+package trino
+
+var Keywords = []string{
+	"ABSENT",
+	"ADD",
+	"ADMIN",
+	"AFTER",
+	"ALL",
+	"ALTER",
+	"ANALYZE",
+	"AND",
+	"ANY",
+	"AS",
+	"ASC",
+	"AT",
+	"AUTHORIZATION",
+	"BERNOULLI",
+	"BETWEEN",
+	"BOTH",
+	"BY",
+	"CALL",
+	"CASCADE",
+	"CATALOG",
+	"CATALOGS",
+	"COLUMN",
+	"COLUMNS",
+	"COMMENT",
+	"COMMIT",
+	"COMMITTED",
+	"CONDITIONAL",
+	"CONSTRAINT",
+	"COPARTITION",
+	"CROSS",
+	"CUBE",
+	"CURRENT",
+	"CURRENT_CATALOG",
+	"CURRENT_PATH",
+	"CURRENT_ROLE",
+	"CURRENT_SCHEMA",
+	"CURRENT_TIME",
+	"CURRENT_USER",
+	"DATA",
+	"DAY",
+	"DEALLOCATE",
+	"DEFAULT",
+	"DEFINE",
+	"DEFINER",
+	"DELETE",
+	"DENY",
+	"DESC",
+	"DESCRIBE",
+	"DESCRIPTOR",
+	"DISTINCT",
+	"DISTRIBUTED",
+	"DROP",
+	"ELSE",
+	"EMPTY",
+	"ENCODING",
+	"ERROR",
+	"ESCAPE",
+	"EXCEPT",
+	"EXCLUDING",
+	"EXECUTE",
+	"EXISTS",
+	"EXPLAIN",
+	"FALSE",
+	"FETCH",
+	"FILTER",
+	"FINAL",
+	"FIRST",
+	"FOLLOWING",
+	"FOR",
+	"FORMAT",
+	"FROM",
+	"FULL",
+	"FUNCTIONS",
+	"GRANT",
+	"GRANTED",
+	"GRANTS",
+	"GRAPHVIZ",
+	"GROUP",
+	"GROUPING",
+	"GROUPS",
+	"HAVING",
+	"HOUR",
+	"IF",
+	"IGNORE",
+	"IN",
+	"INCLUDING",
+	"INITIAL",
+	"INNER",
+	"INPUT",
+	"INTERSECT",
+	"INTO",
+	"INVOKER",
+	"IO",
+	"IS",
+	"ISOLATION",
+	"JOIN",
+	"KEEP",
+	"KEY",
+	"KEYS",
+	"LAST",
+	"LATERAL",
+	"LEADING",
+	"LEFT",
+	"LEVEL",
+	"LIKE",
+	"LIMIT",
+	"LOCAL",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"LOGICAL",
+	"MATCH",
+	"MATCHED",
+	"MATCHES",
+	"MATCH_RECOGNIZE",
+	"MATERIALIZED",
+	"MEASURES",
+	"MERGE",
+	"MINUTE",
+	"MONTH",
+	"NATURAL",
+	"NEXT",
+	"NFC",
+	"NFD",
+	"NFKC",
+	"NFKD",
+	"NO",
+	"NONE",
+	"NOT",
+	"NULL",
+	"NULLS",
+	"OF",
+	"OFFSET",
+	"OMIT",
+	"ON",
+	"ONE",
+	"ONLY",
+	"OPTION",
+	"OR",
+	"ORDER",
+	"ORDINALITY",
+	"OUTER",
+	"OUTPUT",
+	"OVER",
+	"OVERFLOW",
+	"PARTITION",
+	"PARTITIONS",
+	"PASSING",
+	"PAST",
+	"PATH",
+	"PATTERN",
+	"PER",
+	"PERMUTE",
+	"PRECEDING",
+	"PREPARE",
+	"PRIVILEGES",
+	"PROPERTIES",
+	"PRUNE",
+	"QUOTES",
+	"RANGE",
+	"READ",
+	"RECURSIVE",
+	"REFRESH",
+	"RENAME",
+	"REPEATABLE",
+	"REPLACE",
+	"RESET",
+	"RESPECT",
+	"RESTRICT",
+	"RETURNING",
+	"REVOKE",
+	"RIGHT",
+	"ROLE",
+	"ROLES",
+	"ROLLBACK",
+	"ROLLUP",
+	"ROW",
+	"ROWS",
+	"RUNNING",
+	"SCALAR",
+	"SCHEMA",
+	"SCHEMAS",
+	"SECOND",
+	"SECURITY",
+	"SEEK",
+	"SERIALIZABLE",
+	"SESSION",
+	"SET",
+	"SETS",
+	"SHOW",
+	"SKIP",
+	"SOME",
+	"START",
+	"STATS",
+	"SUBSET",
+	"SYSTEM",
+	"TABLE",
+	"TABLES",
+	"TABLESAMPLE",
+	"THEN",
+	"TIES",
+	"TO",
+	"TRAILING",
+	"TRANSACTION",
+	"TRUE",
+	"TRUNCATE",
+	"UESCAPE",
+	"UNBOUNDED",
+	"UNCOMMITTED",
+	"UNCONDITIONAL",
+	"UNION",
+	"UNIQUE",
+	"UNKNOWN",
+	"UNMATCHED",
+	"UPDATE",
+	"USE",
+	"USER",
+	"USING",
+	"UTF16",
+	"UTF32",
+	"UTF8",
+	"VALIDATE",
+	"VALUE",
+	"VALUES",
+	"VERBOSE",
+	"VIEW",
+	"WHEN",
+	"WHERE",
+	"WINDOW",
+	"WITH",
+	"WITHIN",
+	"WITHOUT",
+	"WORK",
+	"WRAPPER",
+	"WRITE",
+	"YEAR",
+	"ZONE",
+}
+
+var DataTypes = []string{
+	"ARRAY",
+	"BIGINT",
+	"BOOLEAN",
+	"CHAR",
+	"DATE",
+	"DECIMAL",
+	"DOUBLE",
+	"HYPERLOGLOG",
+	"INT",
+	"INTEGER",
+	"INTERVAL",
+	"IPADDRESS",
+	"JSON",
+	"MAP",
+	"P4HYPERLOGLOG",
+	"QDIGEST",
+	"REAL",
+	"ROW",
+	"SMALLINT",
+	"TDIGEST",
+	"TIME",
+	"TIMESTAMP",
+	"TINYINT",
+	"UUID",
+	"VARBINARY",
+	"VARCHAR",
+}
+
+var Functions = []string{
+	"ABS",
+	"ACOS",
+	"ALL_MATCH",
+	"ANY_MATCH",
+	"APPROX_DISTINCT",
+	"APPROX_MOST_FREQUENT",
+	"APPROX_PERCENTILE",
+	"APPROX_SET",
+	"ARBITRARY",
+	"ARRAYS_OVERLAP",
+	"ARRAY_AGG",
+	"ARRAY_DISTINCT",
+	"ARRAY_EXCEPT",
+	"ARRAY_INTERSECT",
+	"ARRAY_JOIN",
+	"ARRAY_MAX",
+	"ARRAY_MIN",
+	"ARRAY_POSITION",
+	"ARRAY_REMOVE",
+	"ARRAY_SORT",
+	"ARRAY_UNION",
+	"ASIN",
+	"ATAN",
+	"ATAN2",
+	"AVG",
+	"BITWISE_AND",
+	"BITWISE_OR",
+	"BOOL_AND",
+	"BOOL_OR",
+	"CARDINALITY",
+	"CAST",
+	"CBRT",
+	"CEIL",
+	"CEILING",
+	"CHR",
+	"CLASSIFIER",
+	"COALESCE",
+	"CONCAT",
+	"CONCAT_WS",
+	"CONTAINS",
+	"CORR",
+	"COS",
+	"COUNT",
+	"COUNT_IF",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"CUME_DIST",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"DATE_ADD",
+	"DATE_DIFF",
+	"DATE_FORMAT",
+	"DATE_PARSE",
+	"DATE_TRUNC",
+	"DAY_OF_WEEK",
+	"DEGREES",
+	"DENSE_RANK",
+	"ELEMENT_AT",
+	"EVERY",
+	"EXP",
+	"EXTRACT",
+	"FIRST_VALUE",
+	"FLATTEN",
+	"FLOOR",
+	"FORMAT",
+	"FORMAT_DATETIME",
+	"FROM_ISO8601_TIMESTAMP",
+	"FROM_UNIXTIME",
+	"GEOMETRIC_MEAN",
+	"GREATEST",
+	"HISTOGRAM",
+	"IF",
+	"JSON_ARRAY",
+	"JSON_ARRAY_CONTAINS",
+	"JSON_ARRAY_LENGTH",
+	"JSON_EXISTS",
+	"JSON_EXTRACT",
+	"JSON_EXTRACT_SCALAR",
+	"JSON_FORMAT",
+	"JSON_OBJECT",
+	"JSON_PARSE",
+	"JSON_QUERY",
+	"JSON_SIZE",
+	"JSON_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"LEAST",
+	"LENGTH",
+	"LISTAGG",
+	"LN",
+	"LOG",
+	"LOG10",
+	"LOG2",
+	"LOWER",
+	"LPAD",
+	"LTRIM",
+	"MAP",
+	"MAP_AGG",
+	"MAP_CONCAT",
+	"MAP_ENTRIES",
+	"MAP_FILTER",
+	"MAP_FROM_ENTRIES",
+	"MAP_KEYS",
+	"MAP_VALUES",
+	"MATCH_NUMBER",
+	"MAX",
+	"MAX_BY",
+	"MD5",
+	"MIN",
+	"MIN_BY",
+	"MOD",
+	"NONE_MATCH",
+	"NOW",
+	"NTH_VALUE",
+	"NTILE",
+	"NULLIF",
+	"PERCENT_RANK",
+	"POSITION",
+	"POW",
+	"POWER",
+	"PREV",
+	"RADIANS",
+	"RAND",
+	"RANDOM",
+	"RANK",
+	"REDUCE",
+	"REGEXP_EXTRACT",
+	"REGEXP_EXTRACT_ALL",
+	"REGEXP_LIKE",
+	"REGEXP_REPLACE",
+	"REGEXP_SPLIT",
+	"REPEAT",
+	"REPLACE",
+	"REVERSE",
+	"ROUND",
+	"ROW",
+	"ROW_NUMBER",
+	"RPAD",
+	"RTRIM",
+	"SEQUENCE",
+	"SHA256",
+	"SIGN",
+	"SIN",
+	"SLICE",
+	"SPLIT",
+	"SPLIT_PART",
+	"SQRT",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"STRPOS",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUM",
+	"TAN",
+	"TO_UNIXTIME",
+	"TRANSFORM",
+	"TRANSFORM_KEYS",
+	"TRANSFORM_VALUES",
+	"TRIM",
+	"TRUNCATE",
+	"TRY",
+	"TRY_CAST",
+	"TYPEOF",
+	"UNNEST",
+	"UPPER",
+	"URL_EXTRACT_HOST",
+	"URL_EXTRACT_PARAMETER",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"WIDTH_BUCKET",
+	"ZIP",
+	"ZIP_WITH",
+}
@@ -0,0 +1,72 @@
+package sqlformatter
+
+import "fmt"
+
+// Limits bounds the work done for a single input, for formatting untrusted
+// SQL. A zero field means no limit.
+type Limits struct {
+	// MaxInputBytes is the largest input accepted, in bytes.
+	MaxInputBytes int
+	// MaxNestingDepth is the deepest nesting of parentheses, brackets,
+	// CASE expressions and blocks accepted.
+	MaxNestingDepth int
+	// MaxTokens is the largest number of tokens accepted.
+	MaxTokens int
+}
+
+type LimitKind string
+
+const (
+	LimitInputBytes   LimitKind = "MaxInputBytes"
+	LimitNestingDepth LimitKind = "MaxNestingDepth"
+	LimitTokens       LimitKind = "MaxTokens"
+)
+
+// LimitError is returned when an input exceeds one of the configured Limits.
+type LimitError struct {
+	Limit LimitKind
+	Max   int
+}
+
+func (e LimitError) Error() string {
+	switch e.Limit {
+	case LimitInputBytes:
+		return fmt.Sprintf("Input is larger than the limit of %d bytes", e.Max)
+	case LimitNestingDepth:
+		return fmt.Sprintf("Input is nested deeper than the limit of %d levels", e.Max)
+	case LimitTokens:
+		return fmt.Sprintf("Input has more than the limit of %d tokens", e.Max)
+	default:
+		return fmt.Sprintf("Input exceeds %s of %d", e.Limit, e.Max)
+	}
+}
+
+func validateLimits(limits Limits) error {
+	if limits.MaxInputBytes < 0 || limits.MaxNestingDepth < 0 || limits.MaxTokens < 0 {
+		return ConfigError{Message: "limits config must not be negative."}
+	}
+	return nil
+}
+
+// checkNestingDepth reports a LimitError when tokens nest deeper than max.
+// It runs before parsing, as the parser recurses once per nesting level.
+func checkNestingDepth(tokens []Token, max int) error {
+	if max <= 0 {
+		return nil
+	}
+	depth := 0
+	for _, token := range tokens {
+		switch token.Type {
+		case TokenOpenParen, TokenCase, TokenBlockStart:
+			depth++
+			if depth > max {
+				return LimitError{Limit: LimitNestingDepth, Max: max}
+			}
+		case TokenCloseParen, TokenEnd, TokenBlockEnd:
+			if depth > 0 {
+				depth--
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package sqlformatter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// cancelAfterContext reports cancellation once Err has been called n times.
+type cancelAfterContext struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestFormatContext(t *testing.T) {
+	formatWithLimits := func(query string, limits Limits) error {
+		_, err := FormatContext(context.Background(), query, FormatOptionsWithLanguage{FormatOptions: FormatOptions{Limits: limits}})
+		return err
+	}
+	expectLimit := func(t *testing.T, err error, kind LimitKind) {
+		t.Helper()
+		var limitErr LimitError
+		if !errors.As(err, &limitErr) || limitErr.Limit != kind {
+			t.Fatalf("expected %s LimitError, got %v", kind, err)
+		}
+	}
+
+	t.Run("formats within the limits", func(t *testing.T) {
+		result, err := FormatContext(context.Background(), "SELECT (a + (b)) FROM t", FormatOptionsWithLanguage{
+			FormatOptions: FormatOptions{Limits: Limits{MaxInputBytes: 100, MaxNestingDepth: 2, MaxTokens: 10}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := dedent(`
+			SELECT
+			  (a + (b))
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("limits input size", func(t *testing.T) {
+		expectLimit(t, formatWithLimits("SELECT 1234567890", Limits{MaxInputBytes: 10}), LimitInputBytes)
+	})
+
+	t.Run("limits the number of tokens", func(t *testing.T) {
+		expectLimit(t, formatWithLimits("SELECT a, b, c, d FROM t", Limits{MaxTokens: 5}), LimitTokens)
+	})
+
+	t.Run("limits nesting depth", func(t *testing.T) {
+		query := "SELECT " + strings.Repeat("(", 10000) + "1" + strings.Repeat(")", 10000)
+		expectLimit(t, formatWithLimits(query, Limits{MaxNestingDepth: 100}), LimitNestingDepth)
+		expectLimit(t, formatWithLimits("SELECT CASE WHEN a THEN CASE WHEN b THEN 1 END END", Limits{MaxNestingDepth: 1}), LimitNestingDepth)
+	})
+
+	t.Run("rejects negative limits", func(t *testing.T) {
+		var configErr ConfigError
+		if err := formatWithLimits("SELECT 1", Limits{MaxTokens: -1}); !errors.As(err, &configErr) {
+			t.Fatalf("expected ConfigError, got %v", err)
+		}
+	})
+
+	t.Run("honors deadlines", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+		defer cancel()
+		_, err := FormatContext(ctx, "SELECT 1", FormatOptionsWithLanguage{})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("stops while laying out a statement", func(t *testing.T) {
+		query := "SELECT " + strings.Repeat("foo(bar(baz(1, 2), 3), 4) + ", 50) + "1 FROM t"
+		formatter, err := New(FormatOptionsWithLanguage{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ctx := &cancelAfterContext{Context: context.Background(), n: 5}
+		if _, err := formatter.FormatContext(ctx, query); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		if ctx.n > 0 {
+			t.Fatal("expected the context to be checked during formatting")
+		}
+	})
+}
@@ -1,5 +1,10 @@
 package sqlformatter
 
+import (
+	"sort"
+	"unicode/utf8"
+)
+
 func lineColFromIndex(input string, index int) (int, int) {
 	line := 1
 	col := 1
@@ -16,3 +21,43 @@ func lineColFromIndex(input string, index int) (int, int) {
 	}
 	return line, col
 }
+
+// positionIndex maps byte offsets in a source text to Positions, with the same
+// line and column numbering as lineColFromIndex, without rescanning the text
+// from the start for every offset.
+type positionIndex struct {
+	input      string
+	lineStarts []int
+	// asciiLines marks the lines where a column is a byte count
+	asciiLines []bool
+}
+
+func newPositionIndex(input string) *positionIndex {
+	x := &positionIndex{input: input, lineStarts: []int{0}}
+	ascii := true
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c == '\n':
+			x.lineStarts = append(x.lineStarts, i+1)
+			x.asciiLines = append(x.asciiLines, ascii)
+			ascii = true
+		case c >= utf8.RuneSelf:
+			ascii = false
+		}
+	}
+	x.asciiLines = append(x.asciiLines, ascii)
+	return x
+}
+
+func (x *positionIndex) position(offset int) Position {
+	if offset > len(x.input) {
+		offset = len(x.input)
+	}
+	line := sort.SearchInts(x.lineStarts, offset+1) - 1
+	start := x.lineStarts[line]
+	col := offset - start + 1
+	if !x.asciiLines[line] {
+		col = utf8.RuneCountInString(x.input[start:offset]) + 1
+	}
+	return Position{Offset: offset, Line: line + 1, Column: col}
+}
@@ -0,0 +1,107 @@
+package lsp
+
+// The subset of the Language Server Protocol types the server uses. Positions
+// count lines from 0 and characters in UTF-16 code units, as the protocol
+// requires.
+
+type position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start position `json:"start"`
+	End   position `json:"end"`
+}
+
+type textEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type textDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type textDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type didOpenParams struct {
+	TextDocument textDocumentItem `json:"textDocument"`
+}
+
+type didChangeParams struct {
+	TextDocument   textDocumentIdentifier `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type didCloseParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+}
+
+type documentFormattingParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+}
+
+type documentRangeFormattingParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Range        lspRange               `json:"range"`
+}
+
+type documentOnTypeFormattingParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Position     position               `json:"position"`
+	Ch           string                 `json:"ch"`
+}
+
+// Diagnostic severities.
+const (
+	severityError = 1
+)
+
+type diagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type publishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []diagnostic `json:"diagnostics"`
+}
+
+// textDocumentSyncFull makes clients send the whole document on every change.
+const textDocumentSyncFull = 1
+
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities"`
+	ServerInfo   serverInfo         `json:"serverInfo"`
+}
+
+type serverInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type serverCapabilities struct {
+	TextDocumentSync                 textDocumentSyncOptions         `json:"textDocumentSync"`
+	DocumentFormattingProvider       bool                            `json:"documentFormattingProvider"`
+	DocumentRangeFormattingProvider  bool                            `json:"documentRangeFormattingProvider"`
+	DocumentOnTypeFormattingProvider documentOnTypeFormattingOptions `json:"documentOnTypeFormattingProvider"`
+}
+
+type textDocumentSyncOptions struct {
+	OpenClose bool `json:"openClose"`
+	Change    int  `json:"change"`
+}
+
+type documentOnTypeFormattingOptions struct {
+	FirstTriggerCharacter string `json:"firstTriggerCharacter"`
+}
@@ -0,0 +1,273 @@
+// Package lsp implements a Language Server Protocol server that formats SQL
+// documents and publishes their parse errors as diagnostics.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	sqlformatter "sql-formatter-go"
+)
+
+// Server is a Language Server Protocol server for SQL documents. It supports
+// whole document, range and on-type formatting, the latter after a semicolon
+// is typed, and reports parse errors as diagnostics when a document is opened
+// or changed.
+type Server struct {
+	// Version is reported to clients in the initialize response.
+	Version string
+
+	formatter *sqlformatter.Formatter
+	conn      *conn
+	// docs holds the text of the open documents by URI
+	docs     map[string]string
+	shutdown bool
+}
+
+// NewServer returns a Server that formats documents with formatter.
+func NewServer(formatter *sqlformatter.Formatter) *Server {
+	return &Server{formatter: formatter, docs: map[string]string{}}
+}
+
+// Serve handles the messages a client writes to r, writing responses and
+// notifications to w, until the client sends exit or closes r. Messages are
+// handled one at a time, in order. ctx applies to formatting, and Serve
+// returns ctx.Err() before reading another message once ctx is done.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if msg.Error != nil && msg.Method == "" {
+			// the body was not valid JSON
+			null := json.RawMessage("null")
+			if err := s.conn.reply(&null, nil, msg.Error); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "" {
+			// a response to a request the server never sends
+			continue
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("lsp: exit before shutdown")
+			}
+			return nil
+		}
+
+		result, rpcErr := s.handle(ctx, msg)
+		if msg.ID == nil {
+			continue
+		}
+		if err := s.conn.reply(msg.ID, result, rpcErr); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, msg *message) (interface{}, *responseError) {
+	if s.shutdown && msg.ID != nil {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is shut down"}
+	}
+	switch msg.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:                 textDocumentSyncOptions{OpenClose: true, Change: textDocumentSyncFull},
+				DocumentFormattingProvider:       true,
+				DocumentRangeFormattingProvider:  true,
+				DocumentOnTypeFormattingProvider: documentOnTypeFormattingOptions{FirstTriggerCharacter: ";"},
+			},
+			ServerInfo: serverInfo{Name: "sql-formatter", Version: s.Version},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		s.docs[params.TextDocument.URI] = params.TextDocument.Text
+		s.publishDiagnostics(ctx, params.TextDocument.URI)
+		return nil, nil
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			// with full sync, the last change holds the whole document
+			s.docs[params.TextDocument.URI] = params.ContentChanges[n-1].Text
+		}
+		s.publishDiagnostics(ctx, params.TextDocument.URI)
+		return nil, nil
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		delete(s.docs, params.TextDocument.URI)
+		s.notifyDiagnostics(params.TextDocument.URI, []diagnostic{})
+		return nil, nil
+
+	case "textDocument/formatting":
+		var params documentFormattingParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		text, rpcErr := s.document(params.TextDocument.URI)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		edits, err := s.formatter.FormatEditsContext(ctx, text)
+		return textEdits(text, edits, err), nil
+	case "textDocument/rangeFormatting":
+		var params documentRangeFormattingParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		text, rpcErr := s.document(params.TextDocument.URI)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		start := offsetOf(text, params.Range.Start)
+		end := offsetOf(text, params.Range.End)
+		if end < start {
+			start, end = end, start
+		}
+		edits, err := s.formatter.FormatRangeContext(ctx, text, start, end)
+		return textEdits(text, edits, err), nil
+	case "textDocument/onTypeFormatting":
+		var params documentOnTypeFormattingParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		text, rpcErr := s.document(params.TextDocument.URI)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		// the position is just after the semicolon that was typed
+		offset := offsetOf(text, params.Position)
+		if params.Ch != ";" || offset == 0 || text[offset-1] != ';' {
+			return []textEdit{}, nil
+		}
+		edits, err := s.formatter.FormatRangeContext(ctx, text, offset-1, offset)
+		return textEdits(text, edits, err), nil
+
+	default:
+		if msg.ID == nil {
+			// notifications such as $/cancelRequest may be ignored
+			return nil, nil
+		}
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+}
+
+func (s *Server) document(uri string) (string, *responseError) {
+	text, ok := s.docs[uri]
+	if !ok {
+		return "", &responseError{Code: codeInvalidParams, Message: "document is not open: " + uri}
+	}
+	return text, nil
+}
+
+func invalidParams(err error) *responseError {
+	return &responseError{Code: codeInvalidParams, Message: err.Error()}
+}
+
+// textEdits converts edits of text to the protocol's form. Formatting errors
+// result in no edits, as they are already reported as diagnostics.
+func textEdits(text string, edits []sqlformatter.TextEdit, err error) []textEdit {
+	if err != nil {
+		return nil
+	}
+	result := make([]textEdit, len(edits))
+	for i, edit := range edits {
+		result[i] = textEdit{
+			Range:   lspRange{Start: positionOf(text, edit.Start), End: positionOf(text, edit.End)},
+			NewText: edit.NewText,
+		}
+	}
+	return result
+}
+
+// publishDiagnostics reports the parse error of the document at uri, or
+// clears the previous one.
+func (s *Server) publishDiagnostics(ctx context.Context, uri string) {
+	text := s.docs[uri]
+	diagnostics := []diagnostic{}
+	_, err := s.formatter.FormatContext(ctx, text)
+	var parseErr sqlformatter.ParseError
+	if errors.As(err, &parseErr) {
+		end := parseErr.Offset + len(parseErr.Token)
+		if end > len(text) {
+			end = len(text)
+		}
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    lspRange{Start: positionOf(text, parseErr.Offset), End: positionOf(text, end)},
+			Severity: severityError,
+			Source:   "sql-formatter",
+			Message:  parseErr.Message(),
+		})
+	}
+	s.notifyDiagnostics(uri, diagnostics)
+}
+
+func (s *Server) notifyDiagnostics(uri string, diagnostics []diagnostic) {
+	// a client that stopped reading ends Serve on the next reply
+	_ = s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: uri, Diagnostics: diagnostics})
+}
+
+// positionOf returns the protocol position of the byte offset in text.
+func positionOf(text string, offset int) position {
+	before := text[:offset]
+	lineStart := strings.LastIndexByte(before, '\n') + 1
+	character := 0
+	for _, r := range before[lineStart:] {
+		character += utf16Len(r)
+	}
+	return position{Line: strings.Count(before, "\n"), Character: character}
+}
+
+// offsetOf returns the byte offset in text of the protocol position pos,
+// clamped to the end of its line and of text.
+func offsetOf(text string, pos position) int {
+	offset := 0
+	for line := 0; line < pos.Line; line++ {
+		next := strings.IndexByte(text[offset:], '\n')
+		if next < 0 {
+			return len(text)
+		}
+		offset += next + 1
+	}
+	character := 0
+	for i, r := range text[offset:] {
+		if character >= pos.Character || r == '\n' {
+			return offset + i
+		}
+		character += utf16Len(r)
+	}
+	return len(text)
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
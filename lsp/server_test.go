@@ -0,0 +1,237 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	sqlformatter "sql-formatter-go"
+)
+
+// client talks to a Server over in-memory pipes, as an editor would over
+// stdio.
+type client struct {
+	t           *testing.T
+	conn        *conn
+	nextID      int
+	messages    chan *message
+	done        chan error
+	diagnostics map[string][]diagnostic
+}
+
+func startServer(t *testing.T) *client {
+	t.Helper()
+	formatter, err := sqlformatter.New(sqlformatter.FormatOptionsWithLanguage{Language: sqlformatter.LanguagePostgresql})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	c := &client{
+		t:           t,
+		conn:        newConn(clientR, clientW),
+		messages:    make(chan *message, 16),
+		done:        make(chan error, 1),
+		diagnostics: map[string][]diagnostic{},
+	}
+	go func() {
+		err := NewServer(formatter).Serve(context.Background(), serverR, serverW)
+		serverW.Close()
+		c.done <- err
+	}()
+	// the pipes are unbuffered, so the client reads concurrently to let the
+	// server publish diagnostics while the client writes
+	go func() {
+		defer close(c.messages)
+		for {
+			msg, err := c.conn.read()
+			if err != nil {
+				return
+			}
+			c.messages <- msg
+		}
+	}()
+	t.Cleanup(func() { clientW.Close() })
+	return c
+}
+
+// call sends a request and returns its response, recording the diagnostics
+// published in the meantime.
+func (c *client) call(method string, params interface{}) *message {
+	c.t.Helper()
+	c.nextID++
+	id := mustMarshal(c.t, c.nextID)
+	if err := c.conn.write(&message{ID: &id, Method: method, Params: mustMarshal(c.t, params)}); err != nil {
+		c.t.Fatalf("write %s: %v", method, err)
+	}
+	for {
+		msg, ok := <-c.messages
+		if !ok {
+			c.t.Fatalf("connection closed before the %s response", method)
+		}
+		if msg.Method == "textDocument/publishDiagnostics" {
+			var params publishDiagnosticsParams
+			if err := json.Unmarshal(msg.Params, &params); err != nil {
+				c.t.Fatalf("unexpected diagnostics %s: %v", msg.Params, err)
+			}
+			c.diagnostics[params.URI] = params.Diagnostics
+			continue
+		}
+		if msg.ID == nil || string(*msg.ID) != string(id) {
+			c.t.Fatalf("unexpected message %+v", msg)
+		}
+		return msg
+	}
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.t.Helper()
+	if err := c.conn.write(&message{Method: method, Params: mustMarshal(c.t, params)}); err != nil {
+		c.t.Fatalf("write %s: %v", method, err)
+	}
+}
+
+// result calls method and decodes its result into out.
+func (c *client) result(method string, params interface{}, out interface{}) {
+	c.t.Helper()
+	msg := c.call(method, params)
+	if msg.Error != nil {
+		c.t.Fatalf("%s failed: %s", method, msg.Error.Message)
+	}
+	if err := json.Unmarshal(msg.Result, out); err != nil {
+		c.t.Fatalf("unexpected %s result %s: %v", method, msg.Result, err)
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return data
+}
+
+func applyEdits(t *testing.T, text string, edits []textEdit) string {
+	t.Helper()
+	for i := len(edits) - 1; i >= 0; i-- {
+		start, end := offsetOf(text, edits[i].Range.Start), offsetOf(text, edits[i].Range.End)
+		text = text[:start] + edits[i].NewText + text[end:]
+	}
+	return text
+}
+
+const uri = "file:///query.sql"
+
+func open(c *client, text string) {
+	c.notify("textDocument/didOpen", didOpenParams{TextDocument: textDocumentItem{URI: uri, LanguageID: "sql", Version: 1, Text: text}})
+}
+
+func TestServer(t *testing.T) {
+	t.Run("initializes and shuts down", func(t *testing.T) {
+		c := startServer(t)
+		var result initializeResult
+		c.result("initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, &result)
+		caps := result.Capabilities
+		if !caps.DocumentFormattingProvider || !caps.DocumentRangeFormattingProvider || caps.DocumentOnTypeFormattingProvider.FirstTriggerCharacter != ";" {
+			t.Errorf("unexpected capabilities %+v", caps)
+		}
+		c.notify("initialized", struct{}{})
+		if msg := c.call("shutdown", nil); msg.Error != nil || string(msg.Result) != "null" {
+			t.Errorf("unexpected shutdown response %+v", msg)
+		}
+		c.notify("exit", nil)
+		if err := <-c.done; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("formats a document", func(t *testing.T) {
+		c := startServer(t)
+		text := "-- users\nselect id, name from users where id = 1;\n"
+		open(c, text)
+		var edits []textEdit
+		c.result("textDocument/formatting", documentFormattingParams{TextDocument: textDocumentIdentifier{URI: uri}}, &edits)
+		if len(edits) != 1 || edits[0].Range.Start != (position{Line: 1}) {
+			t.Fatalf("unexpected edits %+v", edits)
+		}
+		expected := "-- users\nselect\n  id,\n  name\nfrom\n  users\nwhere\n  id = 1;\n"
+		if got := applyEdits(t, text, edits); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("formats a range", func(t *testing.T) {
+		c := startServer(t)
+		text := "select a from b;\nselect c from d;\n"
+		open(c, text)
+		var edits []textEdit
+		c.result("textDocument/rangeFormatting", documentRangeFormattingParams{
+			TextDocument: textDocumentIdentifier{URI: uri},
+			Range:        lspRange{Start: position{Line: 1, Character: 7}, End: position{Line: 1, Character: 8}},
+		}, &edits)
+		expected := "select a from b;\nselect\n  c\nfrom\n  d;\n"
+		if got := applyEdits(t, text, edits); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("formats the statement ended by a typed semicolon", func(t *testing.T) {
+		c := startServer(t)
+		text := "select a from b;\nselect 'ü', c from d;\nselect e"
+		open(c, text)
+		var edits []textEdit
+		c.result("textDocument/onTypeFormatting", documentOnTypeFormattingParams{
+			TextDocument: textDocumentIdentifier{URI: uri},
+			Position:     position{Line: 1, Character: 21},
+			Ch:           ";",
+		}, &edits)
+		expected := "select a from b;\nselect\n  'ü',\n  c\nfrom\n  d;\nselect e"
+		if got := applyEdits(t, text, edits); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("publishes parse errors as diagnostics", func(t *testing.T) {
+		c := startServer(t)
+		open(c, "select a from b;\nselect (c from d;")
+		c.call("shutdown", nil)
+		diagnostics := c.diagnostics[uri]
+		if len(diagnostics) != 1 {
+			t.Fatalf("expected 1 diagnostic, got %+v", diagnostics)
+		}
+		d := diagnostics[0]
+		if d.Range.Start != (position{Line: 1, Character: 16}) || d.Range.End != (position{Line: 1, Character: 17}) || d.Severity != severityError {
+			t.Errorf("unexpected diagnostic %+v", d)
+		}
+		if d.Message != `Parse error: Unexpected ";", expected ")"` {
+			t.Errorf("unexpected message %q", d.Message)
+		}
+	})
+
+	t.Run("clears diagnostics once the error is fixed", func(t *testing.T) {
+		c := startServer(t)
+		open(c, "select (a")
+		c.notify("textDocument/didChange", didChangeParams{
+			TextDocument: textDocumentIdentifier{URI: uri},
+			ContentChanges: []struct {
+				Text string `json:"text"`
+			}{{Text: "select (a)"}},
+		})
+		c.call("shutdown", nil)
+		if diagnostics, ok := c.diagnostics[uri]; !ok || len(diagnostics) != 0 {
+			t.Errorf("expected no diagnostics, got %+v", diagnostics)
+		}
+	})
+
+	t.Run("rejects unknown methods and documents", func(t *testing.T) {
+		c := startServer(t)
+		if msg := c.call("workspace/symbol", struct{}{}); msg.Error == nil || msg.Error.Code != codeMethodNotFound {
+			t.Errorf("expected method not found, got %+v", msg)
+		}
+		msg := c.call("textDocument/formatting", documentFormattingParams{TextDocument: textDocumentIdentifier{URI: "file:///missing.sql"}})
+		if msg.Error == nil || msg.Error.Code != codeInvalidParams {
+			t.Errorf("expected invalid params, got %+v", msg)
+		}
+	})
+}
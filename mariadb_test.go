@@ -0,0 +1,25 @@
+package sqlformatter
+
+import "testing"
+
+func TestMariadbFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageMariadb, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageMariadb, query, cfg...)
+	}
+
+	behavesLikeMariaDbFormatter(t, format, formatErr)
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "UNION DISTINCT", "EXCEPT", "EXCEPT ALL", "EXCEPT DISTINCT", "INTERSECT", "INTERSECT ALL", "INTERSECT DISTINCT"})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true, ColumnComment: true, TableComment: true})
+	supportsReturning(t, format)
+
+	t.Run("formats CREATE SEQUENCE", func(t *testing.T) {
+		result := format("CREATE OR REPLACE SEQUENCE IF NOT EXISTS s INCREMENT BY 2;")
+		expected := "CREATE OR REPLACE SEQUENCE IF NOT EXISTS s INCREMENT BY 2;"
+		assertEqual(t, result, expected)
+	})
+}
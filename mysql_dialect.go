@@ -0,0 +1,300 @@
+package sqlformatter
+
+import mysql "sql-formatter-go/languages/mysql"
+
+var mysqlReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT | DISTINCTROW]",
+}
+
+var mysqlReservedClausesPhrases = []string{
+	"WITH [RECURSIVE]",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"WINDOW",
+	"PARTITION BY",
+	"ORDER BY",
+	"LIMIT",
+	"OFFSET",
+	"INSERT [LOW_PRIORITY | DELAYED | HIGH_PRIORITY] [IGNORE] [INTO]",
+	"REPLACE [LOW_PRIORITY | DELAYED] [INTO]",
+	"VALUES",
+	"ON DUPLICATE KEY UPDATE",
+	"SET",
+}
+
+var mysqlStandardOnelineClausesPhrases = []string{
+	"CREATE [TEMPORARY] TABLE [IF NOT EXISTS]",
+}
+
+var mysqlTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [SQL SECURITY DEFINER | SQL SECURITY INVOKER] VIEW [IF NOT EXISTS]",
+	"UPDATE [LOW_PRIORITY] [IGNORE]",
+	"DELETE [LOW_PRIORITY] [QUICK] [IGNORE] FROM",
+	"DROP [TEMPORARY] TABLE [IF EXISTS]",
+	"ALTER TABLE",
+	"ADD [COLUMN]",
+	"{CHANGE | MODIFY} [COLUMN]",
+	"DROP [COLUMN]",
+	"RENAME [TO | AS]",
+	"RENAME COLUMN",
+	"ALTER [COLUMN]",
+	"{SET | DROP} DEFAULT",
+	"TRUNCATE [TABLE]",
+	"ALTER DATABASE",
+	"ALTER EVENT",
+	"ALTER FUNCTION",
+	"ALTER INSTANCE",
+	"ALTER LOGFILE GROUP",
+	"ALTER PROCEDURE",
+	"ALTER RESOURCE GROUP",
+	"ALTER SERVER",
+	"ALTER TABLESPACE",
+	"ALTER USER",
+	"ALTER VIEW",
+	"ANALYZE TABLE",
+	"BINLOG",
+	"CACHE INDEX",
+	"CALL",
+	"CHANGE MASTER TO",
+	"CHANGE REPLICATION FILTER",
+	"CHANGE REPLICATION SOURCE TO",
+	"CHECK TABLE",
+	"CHECKSUM TABLE",
+	"CLONE",
+	"COMMIT",
+	"CREATE DATABASE",
+	"CREATE EVENT",
+	"CREATE FUNCTION",
+	"CREATE [UNIQUE | FULLTEXT | SPATIAL] INDEX",
+	"CREATE LOGFILE GROUP",
+	"CREATE PROCEDURE",
+	"CREATE RESOURCE GROUP",
+	"CREATE ROLE",
+	"CREATE SERVER",
+	"CREATE SPATIAL REFERENCE SYSTEM",
+	"CREATE TABLESPACE",
+	"CREATE TRIGGER",
+	"CREATE USER",
+	"DEALLOCATE PREPARE",
+	"DESCRIBE",
+	"DROP DATABASE",
+	"DROP EVENT",
+	"DROP FUNCTION",
+	"DROP INDEX",
+	"DROP LOGFILE GROUP",
+	"DROP PROCEDURE",
+	"DROP RESOURCE GROUP",
+	"DROP ROLE",
+	"DROP SERVER",
+	"DROP SPATIAL REFERENCE SYSTEM",
+	"DROP TABLESPACE",
+	"DROP TRIGGER",
+	"DROP USER",
+	"DROP VIEW",
+	"EXECUTE",
+	"EXPLAIN",
+	"FLUSH",
+	"GRANT",
+	"HANDLER",
+	"HELP",
+	"IMPORT TABLE",
+	"INSTALL COMPONENT",
+	"INSTALL PLUGIN",
+	"KILL",
+	"LOAD DATA",
+	"LOAD INDEX INTO CACHE",
+	"LOAD XML",
+	"LOCK INSTANCE FOR BACKUP",
+	"LOCK TABLES",
+	"OPTIMIZE TABLE",
+	"PREPARE",
+	"PURGE BINARY LOGS",
+	"RELEASE SAVEPOINT",
+	"RENAME TABLE",
+	"RENAME USER",
+	"REPAIR TABLE",
+	"RESET",
+	"RESET MASTER",
+	"RESET PERSIST",
+	"RESET REPLICA",
+	"RESET SLAVE",
+	"RESTART",
+	"REVOKE",
+	"ROLLBACK",
+	"ROLLBACK TO SAVEPOINT",
+	"SAVEPOINT",
+	"SET CHARACTER SET",
+	"SET DEFAULT ROLE",
+	"SET NAMES",
+	"SET PASSWORD",
+	"SET RESOURCE GROUP",
+	"SET ROLE",
+	"SET TRANSACTION",
+	"SHOW",
+	"SHUTDOWN",
+	"START GROUP_REPLICATION",
+	"START REPLICA",
+	"START SLAVE",
+	"START TRANSACTION",
+	"STOP GROUP_REPLICATION",
+	"STOP REPLICA",
+	"STOP SLAVE",
+	"TABLE",
+	"UNINSTALL COMPONENT",
+	"UNINSTALL PLUGIN",
+	"UNLOCK INSTANCE",
+	"UNLOCK TABLES",
+	"USE",
+	"XA",
+	"ITERATE",
+	"LEAVE",
+	"LOOP",
+	"REPEAT",
+	"RETURN",
+	"WHILE",
+}
+
+var mysqlReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT]",
+}
+
+var mysqlReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"NATURAL [INNER] JOIN",
+	"NATURAL {LEFT | RIGHT} [OUTER] JOIN",
+	"STRAIGHT_JOIN",
+}
+
+var mysqlReservedKeywordPhrasesPhrases = []string{
+	"ON {UPDATE | DELETE} [SET NULL | SET DEFAULT]",
+	"CHARACTER SET",
+	"{ROWS | RANGE} BETWEEN",
+	"IDENTIFIED BY",
+}
+
+var mysqlOperators = []string{
+	"%",
+	":=",
+	"&",
+	"|",
+	"^",
+	"~",
+	"<<",
+	">>",
+	"<=>",
+	"->",
+	"->>",
+	"&&",
+	"||",
+	"!",
+}
+
+var mysqlStringTypes = []QuoteType{
+	PlainQuoteType("\"\"-qq-bs"),
+	PrefixedQuoteType{Quote: PlainQuoteType("''-qq-bs"), Prefixes: []string{"N"}},
+	PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: []string{"B", "X"}, RequirePrefix: true},
+}
+
+var mysqlVariableTypes = []VariableType{
+	RegexPattern{Regex: `@@?[A-Za-z0-9_.$]+`},
+	PrefixedQuoteType{Quote: PlainQuoteType("\"\"-qq-bs"), Prefixes: []string{"@"}, RequirePrefix: true},
+	PrefixedQuoteType{Quote: PlainQuoteType("''-qq-bs"), Prefixes: []string{"@"}, RequirePrefix: true},
+	PrefixedQuoteType{Quote: PlainQuoteType("``"), Prefixes: []string{"@"}, RequirePrefix: true},
+}
+
+var MysqlDialect = DialectOptions{
+	Name: "mysql",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(mysqlReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(mysqlReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(mysqlStandardOnelineClausesPhrases)...), ExpandPhrases(mysqlTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(mysqlReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(mysqlReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(mysqlReservedKeywordPhrasesPhrases),
+		SupportsXor:            true,
+		ReservedKeywords:       mysql.Keywords,
+		ReservedDataTypes:      mysql.DataTypes,
+		ReservedFunctionNames:  mysql.Functions,
+		StringTypes:            mysqlStringTypes,
+		IdentTypes:             []QuoteType{PlainQuoteType("``")},
+		IdentChars:             &IdentChars{First: "$", Rest: "$", AllowFirstCharNumber: true},
+		VariableTypes:          mysqlVariableTypes,
+		ParamTypes:             &ParamTypes{Positional: true},
+		LineCommentTypes:       []string{"--", "#"},
+		Operators:              mysqlOperators,
+		PostProcess:            mysqlPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(mysqlStandardOnelineClausesPhrases)...), ExpandPhrases(mysqlTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(mysqlTabularOnelineClausesPhrases),
+	},
+}
+
+// MariaDB shares the MySQL grammar and adds RETURNING, EXCEPT/INTERSECT and
+// sequence statements on top of it.
+var mariadbReservedClausesPhrases = append(append([]string{}, mysqlReservedClausesPhrases...), "RETURNING")
+
+var mariadbTabularOnelineClausesPhrases = append(append([]string{}, mysqlTabularOnelineClausesPhrases...),
+	"CREATE [OR REPLACE] [TEMPORARY] SEQUENCE [IF NOT EXISTS]",
+	"ALTER SEQUENCE [IF EXISTS]",
+	"DROP [TEMPORARY] SEQUENCE [IF EXISTS]",
+)
+
+var mariadbReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT]",
+	"EXCEPT [ALL | DISTINCT]",
+	"INTERSECT [ALL | DISTINCT]",
+}
+
+var MariadbDialect = DialectOptions{
+	Name: "mariadb",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(mysqlReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(mariadbReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(mysqlStandardOnelineClausesPhrases)...), ExpandPhrases(mariadbTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(mariadbReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(mysqlReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(mysqlReservedKeywordPhrasesPhrases),
+		SupportsXor:            true,
+		ReservedKeywords:       mysql.Keywords,
+		ReservedDataTypes:      mysql.DataTypes,
+		ReservedFunctionNames:  mysql.Functions,
+		StringTypes:            mysqlStringTypes,
+		IdentTypes:             []QuoteType{PlainQuoteType("``")},
+		IdentChars:             &IdentChars{First: "$", Rest: "$", AllowFirstCharNumber: true},
+		VariableTypes:          mysqlVariableTypes,
+		ParamTypes:             &ParamTypes{Positional: true},
+		LineCommentTypes:       []string{"--", "#"},
+		Operators:              mysqlOperators,
+		PostProcess:            mysqlPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(mysqlStandardOnelineClausesPhrases)...), ExpandPhrases(mariadbTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(mariadbTabularOnelineClausesPhrases),
+	},
+}
+
+// mysqlPostProcess turns SET(...), REPLACE(...) and VALUES(...) into function
+// calls where MySQL treats them as such: the SET data type, the REPLACE()
+// string function and VALUES(col) inside ON DUPLICATE KEY UPDATE.
+func mysqlPostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenReservedClause {
+			continue
+		}
+		if !isOpenParen(nextNonCommentToken(tokens, i)) {
+			continue
+		}
+		switch token.Text {
+		case "SET", "REPLACE":
+			tokens[i].Type = TokenReservedFunctionName
+		case "VALUES":
+			if prevNonCommentToken(tokens, i).Type == TokenOperator {
+				tokens[i].Type = TokenReservedFunctionName
+			}
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,45 @@
+package sqlformatter
+
+import "testing"
+
+func TestMysqlFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageMysql, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageMysql, query, cfg...)
+	}
+
+	behavesLikeMariaDbFormatter(t, format, formatErr)
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "UNION DISTINCT"})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true, ColumnComment: true, TableComment: true})
+	supportsOperators(t, format, []string{"%", ":=", "&", "|", "^", "~", "<<", ">>", "<=>", "->", "->>", "&&", "||", "!"}, operatorConfig{LogicalOperators: []string{"AND", "OR", "XOR"}})
+
+	t.Run("supports STRAIGHT_JOIN and NATURAL joins", func(t *testing.T) {
+		result := format("SELECT * FROM a STRAIGHT_JOIN b ON a.id = b.id;")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  a
+			  STRAIGHT_JOIN b ON a.id = b.id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats ALTER TABLE ... ALTER COLUMN", func(t *testing.T) {
+		result := format("ALTER TABLE t ALTER COLUMN foo SET DEFAULT 10;\nALTER TABLE t ALTER COLUMN foo DROP DEFAULT;")
+		expected := dedent(`
+			ALTER TABLE t
+			ALTER COLUMN foo
+			SET DEFAULT 10;
+
+			ALTER TABLE t
+			ALTER COLUMN foo
+			DROP DEFAULT;
+		`)
+		assertEqual(t, result, expected)
+	})
+}
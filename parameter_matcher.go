@@ -1,6 +1,7 @@
 package sqlformatter
 
 import (
+	"regexp"
 	"strings"
 	"unicode"
 )
@@ -74,3 +75,19 @@ func (m *NumberedParameterMatcher) Match(input string, index int) (string, bool)
 	}
 	return "", false
 }
+
+// TypedParameterMatcher matches {name:Type} parameters. The type may itself
+// contain parentheses and commas, e.g. {ids:Array(UInt64)}.
+type TypedParameterMatcher struct{}
+
+var typedParameterRegex = regexp.MustCompile(`^\{\s*[A-Za-z_][A-Za-z0-9_]*\s*:\s*[^{}]+\}`)
+
+func (TypedParameterMatcher) Match(input string, index int) (string, bool) {
+	match := typedParameterRegex.FindString(input[index:])
+	return match, match != ""
+}
+
+func typedParameterKey(raw string) string {
+	name, _, _ := strings.Cut(raw[1:], ":")
+	return strings.TrimSpace(name)
+}
@@ -14,6 +14,35 @@ func NewParams(params ParamItemsOrList) *Params {
 	return &Params{params: params, index: 0}
 }
 
+// copyParams copies the supported params containers so a Formatter is not
+// affected by later changes the caller makes to them.
+func copyParams(params ParamItemsOrList) ParamItemsOrList {
+	switch v := params.(type) {
+	case []string:
+		return append([]string(nil), v...)
+	case ParamItems:
+		out := make(ParamItems, len(v))
+		for key, val := range v {
+			out[key] = val
+		}
+		return out
+	case map[string]string:
+		out := make(map[string]string, len(v))
+		for key, val := range v {
+			out[key] = val
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			out[key] = val
+		}
+		return out
+	default:
+		return params
+	}
+}
+
 func (p *Params) Get(key string, text string) string {
 	if p == nil || p.params == nil {
 		return text
@@ -0,0 +1,127 @@
+package sqlformatter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseError is returned for SQL that cannot be tokenized or parsed.
+type ParseError struct {
+	// Offset is the byte offset of the error in the input. Line and Column
+	// are 1-based, and Column counts runes.
+	Offset int
+	Line   int
+	Column int
+	// Token is the unexpected source text, or empty at the end of the input.
+	Token string
+	// Expected lists what the parser would have accepted instead, such as
+	// ")" or "THEN". It is empty when any of several constructs would do.
+	Expected []string
+	// Snippet is the line of the input containing the error, followed by a
+	// line with a caret under the offending column.
+	Snippet string
+	// Dialect is the name of the SQL dialect used.
+	Dialect string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("Parse error: %s at line %d column %d.\n%s", e.description(), e.Line, e.Column, dialectInfo(e.Dialect))
+}
+
+// Message describes the error without its location, for tools that report
+// the location in their own format.
+func (e ParseError) Message() string {
+	return "Parse error: " + e.description()
+}
+
+func (e ParseError) description() string {
+	var b strings.Builder
+	if e.Token == "" {
+		b.WriteString("Unexpected end of input")
+	} else {
+		fmt.Fprintf(&b, "Unexpected \"%s\"", e.Token)
+	}
+	if len(e.Expected) > 0 {
+		quoted := make([]string, len(e.Expected))
+		for i, expected := range e.Expected {
+			quoted[i] = "\"" + expected + "\""
+		}
+		b.WriteString(", expected ")
+		b.WriteString(strings.Join(quoted, " or "))
+	}
+	return b.String()
+}
+
+func dialectInfo(dialectName string) string {
+	if dialectName == "sql" {
+		return "This likely happens because you're using the default \"sql\" dialect.\nIf possible, please select a more specific dialect (like sqlite, postgresql, etc)."
+	}
+	return fmt.Sprintf("SQL dialect used: \"%s\".", dialectName)
+}
+
+// newParseError builds the ParseError for the text token found at offset in
+// input.
+func newParseError(input string, offset int, token string, expected []string, dialectName string) ParseError {
+	line, col := lineColFromIndex(input, offset)
+	return ParseError{
+		Offset:   offset,
+		Line:     line,
+		Column:   col,
+		Token:    token,
+		Expected: expected,
+		Snippet:  errorSnippet(input, offset),
+		Dialect:  dialectName,
+	}
+}
+
+// snippetContext is how many runes of the offending line are kept on either
+// side of the error, so that a single-line minified script stays readable.
+const snippetContext = 60
+
+func errorSnippet(input string, offset int) string {
+	if offset > len(input) {
+		offset = len(input)
+	}
+	start := strings.LastIndexByte(input[:offset], '\n') + 1
+	end := strings.IndexByte(input[offset:], '\n')
+	if end < 0 {
+		end = len(input)
+	} else {
+		end += offset
+	}
+	before := strings.TrimRight(input[start:offset], "\r")
+	after := strings.TrimRight(input[offset:end], "\r")
+
+	prefix, suffix := "", ""
+	if extra := utf8.RuneCountInString(before) - snippetContext; extra > 0 {
+		prefix = "..."
+		for ; extra > 0; extra-- {
+			_, size := utf8.DecodeRuneInString(before)
+			before = before[size:]
+		}
+	}
+	if utf8.RuneCountInString(after) > snippetContext {
+		suffix = "..."
+		n := 0
+		for i := range after {
+			if n == snippetContext {
+				after = after[:i]
+				break
+			}
+			n++
+		}
+	}
+
+	// keep tabs in the caret line, so the caret lines up however they render
+	var caret strings.Builder
+	for _, r := range prefix + before {
+		if r == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+	return prefix + before + after + suffix + "\n" + caret.String()
+}
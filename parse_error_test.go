@@ -0,0 +1,95 @@
+package sqlformatter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	parseError := func(t *testing.T, query string, cfg FormatOptionsWithLanguage) ParseError {
+		t.Helper()
+		_, err := Format(query, cfg)
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected ParseError, got %v", err)
+		}
+		return parseErr
+	}
+
+	t.Run("reports text that cannot be tokenized", func(t *testing.T) {
+		err := parseError(t, "SELECT a\nFROM t WHERE x = 'unterminated\n", FormatOptionsWithLanguage{Language: LanguagePostgresql})
+		if err.Offset != 26 || err.Line != 2 || err.Column != 18 {
+			t.Errorf("expected offset 26 at 2:18, got %d at %d:%d", err.Offset, err.Line, err.Column)
+		}
+		assertEqual(t, err.Token, "'untermina")
+		assertEqual(t, err.Error(), "Parse error: Unexpected \"'untermina\" at line 2 column 18.\nSQL dialect used: \"postgresql\".")
+		assertEqual(t, err.Snippet, "FROM t WHERE x = 'unterminated\n                 ^")
+	})
+
+	t.Run("reports the unexpected token and what was expected", func(t *testing.T) {
+		err := parseError(t, "SELECT CASE WHEN a 1 END FROM t", FormatOptionsWithLanguage{Language: LanguagePostgresql})
+		if err.Offset != 21 || err.Line != 1 || err.Column != 22 {
+			t.Errorf("expected offset 21 at 1:22, got %d at %d:%d", err.Offset, err.Line, err.Column)
+		}
+		assertEqual(t, err.Token, "END")
+		assertEqual(t, strings.Join(err.Expected, ","), "THEN")
+		assertEqual(t, err.Message(), "Parse error: Unexpected \"END\", expected \"THEN\"")
+		assertEqual(t, err.Snippet, "SELECT CASE WHEN a 1 END FROM t\n                     ^")
+	})
+
+	t.Run("reports the end of the input", func(t *testing.T) {
+		err := parseError(t, "SELECT (a, b\nFROM t", FormatOptionsWithLanguage{})
+		if err.Line != 2 || err.Column != 7 {
+			t.Errorf("expected 2:7, got %d:%d", err.Line, err.Column)
+		}
+		assertEqual(t, err.Token, "")
+		assertEqual(t, err.Message(), "Parse error: Unexpected end of input, expected \")\"")
+	})
+
+	t.Run("lines up the caret after tabs and multibyte characters", func(t *testing.T) {
+		err := parseError(t, "SELECT\t'ä', (1;", FormatOptionsWithLanguage{})
+		assertEqual(t, err.Snippet, "SELECT\t'ä', (1;\n      \t       ^")
+		if err.Column != 15 {
+			t.Errorf("expected column 15, got %d", err.Column)
+		}
+	})
+
+	t.Run("shortens long lines around the error", func(t *testing.T) {
+		query := "SELECT " + strings.Repeat("a, ", 100) + "CASE WHEN b END" + strings.Repeat(", c", 100)
+		err := parseError(t, query, FormatOptionsWithLanguage{})
+		lines := strings.Split(err.Snippet, "\n")
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "...") || !strings.HasSuffix(lines[0], "...") {
+			t.Fatalf("unexpected snippet %q", err.Snippet)
+		}
+		if len(lines[0]) != 6+2*snippetContext {
+			t.Errorf("expected %d bytes of context, got %q", 2*snippetContext, lines[0])
+		}
+		assertEqual(t, strings.TrimLeft(lines[1], " "), "^")
+		assertEqual(t, lines[0][len(lines[1])-1:len(lines[1])+2], "END")
+	})
+
+	t.Run("reports positions in the whole input from FormatReader", func(t *testing.T) {
+		formatter, err := New(FormatOptionsWithLanguage{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var query strings.Builder
+		for i := 0; i < 5000; i++ {
+			fmt.Fprintf(&query, "SELECT %d FROM t;\n", i)
+		}
+		offset := query.Len() + len("SELECT ")
+		query.WriteString("SELECT CASE WHEN a END;\n")
+		var out strings.Builder
+		err = formatter.FormatReader(context.Background(), strings.NewReader(query.String()), &out)
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected ParseError, got %v", err)
+		}
+		if parseErr.Offset != offset+len("CASE WHEN a ") || parseErr.Line != 5001 || parseErr.Column != 20 {
+			t.Errorf("expected offset %d at 5001:20, got %d at %d:%d", offset+len("CASE WHEN a "), parseErr.Offset, parseErr.Line, parseErr.Column)
+		}
+	})
+}
@@ -1,12 +1,21 @@
 package sqlformatter
 
 import (
+	"context"
 	"fmt"
 )
 
 type Parser struct {
-	tokens []Token
-	index  int
+	tokens    []Token
+	index     int
+	ctx       context.Context
+	limits    Limits
+	positions *positionIndex
+	// dialectName is reported in parse errors
+	dialectName string
+	// unclosedBlock is the offset of the first block left open at the end
+	// of the input, or -1
+	unclosedBlock int
 }
 
 func NewParser(tokenizer *Tokenizer) *Parser {
@@ -17,15 +26,33 @@ func (p *Parser) Parse(sql string, tokenizer *Tokenizer, paramTypesOverrides *Pa
 	if tokenizer == nil {
 		return nil, fmt.Errorf("tokenizer is nil")
 	}
-	tokens, err := tokenizer.Tokenize(sql, paramTypesOverrides)
+	if p.ctx == nil {
+		p.ctx = context.Background()
+	}
+	if p.limits.MaxInputBytes > 0 && len(sql) > p.limits.MaxInputBytes {
+		return nil, LimitError{Limit: LimitInputBytes, Max: p.limits.MaxInputBytes}
+	}
+	tokens, err := tokenizer.tokenize(p.ctx, sql, paramTypesOverrides, p.limits.MaxTokens)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNestingDepth(tokens, p.limits.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 	tokens = DisambiguateTokens(tokens)
+	p.unclosedBlock = -1
+	for _, token := range tokens {
+		if token.unclosedBlock {
+			p.unclosedBlock = token.Start
+			break
+		}
+	}
 	// append EOF token
 	tokens = append(tokens, CreateEofToken(len(sql)))
 	p.tokens = tokens
 	p.index = 0
+	p.positions = newPositionIndex(sql)
+	p.dialectName = tokenizer.dialectName
 	return p.parseMain()
 }
 
@@ -35,6 +62,9 @@ func (p *Parser) parseMain() ([]*StatementNode, error) {
 		if p.peek().Type == TokenEOF {
 			break
 		}
+		if err := p.ctx.Err(); err != nil {
+			return nil, err
+		}
 		stmt, err := p.parseStatement()
 		if err != nil {
 			return nil, err
@@ -54,8 +84,49 @@ func (p *Parser) parseMain() ([]*StatementNode, error) {
 	return statements, nil
 }
 
+// consumedEnd returns the source offset just past the last consumed token.
+func (p *Parser) consumedEnd() int {
+	if p.index == 0 {
+		return 0
+	}
+	return p.tokens[p.index-1].End
+}
+
+// setSpan sets the span of node from the offset start to the end of the last
+// consumed token.
+func (p *Parser) setSpan(node *BaseNode, start int) {
+	node.Start = p.positions.position(start)
+	node.End = p.positions.position(p.consumedEnd())
+}
+
+// setTokenSpan sets the span of a node built from the single token tok.
+func (p *Parser) setTokenSpan(node *BaseNode, tok Token) {
+	node.Start = p.positions.position(tok.Start)
+	node.End = p.positions.position(tok.End)
+}
+
+// errorAt returns the ParseError for the unexpected token tok, when one of
+// expected was wanted instead.
+func (p *Parser) errorAt(tok Token, expected ...string) error {
+	text := tok.Raw
+	if tok.Type == TokenEOF {
+		text = ""
+	}
+	return newParseError(p.positions.input, tok.Start, text, expected, p.dialectName)
+}
+
+func (p *Parser) keywordNode(tok Token) *KeywordNode {
+	kw := &KeywordNode{Type: NodeKeyword, TokenType: tok.Type, Text: tok.Text, Raw: tok.Raw}
+	p.setTokenSpan(&kw.BaseNode, tok)
+	return kw
+}
+
 func (p *Parser) parseStatement() (*StatementNode, error) {
-	children, err := p.parseExpressionsOrClauses(TokenDelimiter, TokenEOF)
+	start := p.peek().Start
+	if p.peek().Type == TokenBatchSeparator {
+		return p.parseBatchSeparator(), nil
+	}
+	children, err := p.parseExpressionsOrClauses(TokenDelimiter, TokenBatchSeparator, TokenEOF)
 	if err != nil {
 		return nil, err
 	}
@@ -63,12 +134,23 @@ func (p *Parser) parseStatement() (*StatementNode, error) {
 	if p.peek().Type == TokenDelimiter {
 		hasSemicolon = true
 		p.consume()
-	} else if p.peek().Type == TokenEOF {
+	} else if p.peek().Type == TokenEOF || p.peek().Type == TokenBatchSeparator {
 		// ok
 	} else {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek())
 	}
-	return &StatementNode{Type: NodeStatement, Children: children, HasSemicolon: hasSemicolon}, nil
+	stmt := &StatementNode{Type: NodeStatement, Children: children, HasSemicolon: hasSemicolon}
+	p.setSpan(&stmt.BaseNode, start)
+	return stmt, nil
+}
+
+// parseBatchSeparator turns a batch separator token into a statement of its
+// own, so that the statement before it ends even without a semicolon.
+func (p *Parser) parseBatchSeparator() *StatementNode {
+	tok := p.consume()
+	stmt := &StatementNode{Type: NodeStatement, Children: []AstNode{p.keywordNode(tok)}, BatchSeparator: true}
+	p.setTokenSpan(&stmt.BaseNode, tok)
+	return stmt
 }
 
 func (p *Parser) parseExpressionsOrClauses(stopTypes ...TokenType) ([]AstNode, error) {
@@ -129,7 +211,7 @@ func (p *Parser) parseClause() (AstNode, bool, error) {
 func (p *Parser) parseLimitClause() (*LimitClauseNode, error) {
 	limitTok := p.consume()
 	trailing := p.parseComments()
-	limitKw := KeywordNode{Type: NodeKeyword, TokenType: limitTok.Type, Text: limitTok.Text, Raw: limitTok.Raw}
+	limitKw := *p.keywordNode(limitTok)
 	limitKw = addTrailingCommentsKeyword(limitKw, trailing)
 
 	expr1, err := p.parseExpressionChainTrailing()
@@ -156,16 +238,20 @@ func (p *Parser) parseLimitClause() (*LimitClauseNode, error) {
 		count = exp2
 	}
 
-	return &LimitClauseNode{Type: NodeLimitClause, LimitKw: limitKw, Count: count, Offset: offset}, nil
+	clause := &LimitClauseNode{Type: NodeLimitClause, LimitKw: limitKw, Count: count, Offset: offset}
+	p.setSpan(&clause.BaseNode, limitTok.Start)
+	return clause, nil
 }
 
 func (p *Parser) parseSelectClause() (*ClauseNode, error) {
 	selectTok := p.consume()
-	nameKw := KeywordNode{Type: NodeKeyword, TokenType: selectTok.Type, Text: selectTok.Text, Raw: selectTok.Raw}
+	nameKw := *p.keywordNode(selectTok)
 	children := []AstNode{}
 	if p.peek().Type == TokenAsterisk {
-		p.consume()
-		children = append(children, &AllColumnsAsteriskNode{Type: NodeAllColumnsAsterisk})
+		tok := p.consume()
+		asterisk := &AllColumnsAsteriskNode{Type: NodeAllColumnsAsterisk}
+		p.setTokenSpan(&asterisk.BaseNode, tok)
+		children = append(children, asterisk)
 		for {
 			node, ok, err := p.parseFreeFormSQL()
 			if err != nil {
@@ -204,12 +290,14 @@ func (p *Parser) parseSelectClause() (*ClauseNode, error) {
 			break
 		}
 	}
-	return &ClauseNode{Type: NodeClause, NameKw: nameKw, Children: children}, nil
+	clause := &ClauseNode{Type: NodeClause, NameKw: nameKw, Children: children}
+	p.setSpan(&clause.BaseNode, selectTok.Start)
+	return clause, nil
 }
 
 func (p *Parser) parseOtherClause() (*ClauseNode, error) {
 	clauseTok := p.consume()
-	nameKw := KeywordNode{Type: NodeKeyword, TokenType: clauseTok.Type, Text: clauseTok.Text, Raw: clauseTok.Raw}
+	nameKw := *p.keywordNode(clauseTok)
 	children := []AstNode{}
 	for {
 		if p.isClauseStart(p.peek()) || p.isStop(TokenDelimiter, TokenEOF, TokenCloseParen) {
@@ -224,12 +312,14 @@ func (p *Parser) parseOtherClause() (*ClauseNode, error) {
 		}
 		children = append(children, node)
 	}
-	return &ClauseNode{Type: NodeClause, NameKw: nameKw, Children: children}, nil
+	clause := &ClauseNode{Type: NodeClause, NameKw: nameKw, Children: children}
+	p.setSpan(&clause.BaseNode, clauseTok.Start)
+	return clause, nil
 }
 
 func (p *Parser) parseSetOperation() (*SetOperationNode, error) {
 	opTok := p.consume()
-	nameKw := KeywordNode{Type: NodeKeyword, TokenType: opTok.Type, Text: opTok.Text, Raw: opTok.Raw}
+	nameKw := *p.keywordNode(opTok)
 	children := []AstNode{}
 	for {
 		if p.isClauseStart(p.peek()) || p.isStop(TokenDelimiter, TokenEOF, TokenCloseParen) {
@@ -244,7 +334,9 @@ func (p *Parser) parseSetOperation() (*SetOperationNode, error) {
 		}
 		children = append(children, node)
 	}
-	return &SetOperationNode{Type: NodeSetOperation, NameKw: nameKw, Children: children}, nil
+	setOp := &SetOperationNode{Type: NodeSetOperation, NameKw: nameKw, Children: children}
+	p.setSpan(&setOp.BaseNode, opTok.Start)
+	return setOp, nil
 }
 
 func (p *Parser) parseExpressionChainTrailing() ([]AstNode, error) {
@@ -271,7 +363,7 @@ func (p *Parser) parseExpressionChainTrailing() ([]AstNode, error) {
 func (p *Parser) parseExpression() (AstNode, bool, error) {
 	if p.peek().Type == TokenAnd || p.peek().Type == TokenOr || p.peek().Type == TokenXor {
 		kw := p.consume()
-		return &KeywordNode{Type: NodeKeyword, TokenType: kw.Type, Text: kw.Text, Raw: kw.Raw}, true, nil
+		return p.keywordNode(kw), true, nil
 	}
 	return p.parseAndlessExpression()
 }
@@ -279,7 +371,9 @@ func (p *Parser) parseExpression() (AstNode, bool, error) {
 func (p *Parser) parseAndlessExpression() (AstNode, bool, error) {
 	if p.peek().Type == TokenAsterisk {
 		tok := p.consume()
-		return &OperatorNode{Type: NodeOperator, Text: tok.Text}, true, nil
+		op := &OperatorNode{Type: NodeOperator, Text: tok.Text}
+		p.setTokenSpan(&op.BaseNode, tok)
+		return op, true, nil
 	}
 	return p.parseAsterisklessAndlessExpression()
 }
@@ -293,13 +387,19 @@ func (p *Parser) parseAsterisklessAndlessExpression() (AstNode, bool, error) {
 		node, err := p.parseCaseExpression()
 		return node, err == nil, err
 	}
+	if p.peek().Type == TokenBlockStart {
+		node, err := p.parseBlock()
+		return node, err == nil, err
+	}
 	return p.parseAtomicExpression()
 }
 
 func (p *Parser) parseFreeFormSQL() (AstNode, bool, error) {
 	if p.peek().Type == TokenAsterisk {
-		p.consume()
-		return &OperatorNode{Type: NodeOperator, Text: "*"}, true, nil
+		tok := p.consume()
+		op := &OperatorNode{Type: NodeOperator, Text: "*"}
+		p.setTokenSpan(&op.BaseNode, tok)
+		return op, true, nil
 	}
 	return p.parseAsterisklessFreeFormSQL()
 }
@@ -308,12 +408,14 @@ func (p *Parser) parseAsterisklessFreeFormSQL() (AstNode, bool, error) {
 	// logic operator
 	if p.peek().Type == TokenAnd || p.peek().Type == TokenOr || p.peek().Type == TokenXor {
 		kw := p.consume()
-		return &KeywordNode{Type: NodeKeyword, TokenType: kw.Type, Text: kw.Text, Raw: kw.Raw}, true, nil
+		return p.keywordNode(kw), true, nil
 	}
 	// comma
 	if p.peek().Type == TokenComma {
-		p.consume()
-		return &CommaNode{Type: NodeComma}, true, nil
+		tok := p.consume()
+		comma := &CommaNode{Type: NodeComma}
+		p.setTokenSpan(&comma.BaseNode, tok)
+		return comma, true, nil
 	}
 	// comment
 	if p.isCommentToken(p.peek()) {
@@ -323,13 +425,14 @@ func (p *Parser) parseAsterisklessFreeFormSQL() (AstNode, bool, error) {
 	// other keyword
 	if p.peek().Type == TokenWhen || p.peek().Type == TokenThen || p.peek().Type == TokenElse || p.peek().Type == TokenEnd {
 		kw := p.consume()
-		return &KeywordNode{Type: NodeKeyword, TokenType: kw.Type, Text: kw.Text, Raw: kw.Raw}, true, nil
+		return p.keywordNode(kw), true, nil
 	}
 	return p.parseAsterisklessAndlessExpression()
 }
 
 func (p *Parser) parseAtomicExpression() (AstNode, bool, error) {
 	var base AstNode
+	start := p.peek().Start
 	// array subscript
 	if p.peek().Type == TokenArrayIdentifier || p.peek().Type == TokenArrayKeyword {
 		node, ok, err := p.parseArraySubscript()
@@ -369,38 +472,48 @@ func (p *Parser) parseAtomicExpression() (AstNode, bool, error) {
 	// operator
 	if p.peek().Type == TokenOperator {
 		tok := p.consume()
-		base = &OperatorNode{Type: NodeOperator, Text: tok.Text}
+		op := &OperatorNode{Type: NodeOperator, Text: tok.Text}
+		p.setTokenSpan(&op.BaseNode, tok)
+		base = op
 		goto propertyAccess
 	}
 	// identifier
 	if p.peek().Type == TokenIdentifier || p.peek().Type == TokenQuotedIdentifier || p.peek().Type == TokenVariable {
 		tok := p.consume()
 		quoted := tok.Type != TokenIdentifier
-		base = &IdentifierNode{Type: NodeIdentifier, Quoted: quoted, Text: tok.Text}
+		ident := &IdentifierNode{Type: NodeIdentifier, Quoted: quoted, Text: tok.Text}
+		p.setTokenSpan(&ident.BaseNode, tok)
+		base = ident
 		goto propertyAccess
 	}
 	// parameter
 	if p.isParameterToken(p.peek()) {
 		tok := p.consume()
-		base = &ParameterNode{Type: NodeParameter, Key: tok.Key, Text: tok.Text}
+		param := &ParameterNode{Type: NodeParameter, Key: tok.Key, Text: tok.Text}
+		p.setTokenSpan(&param.BaseNode, tok)
+		base = param
 		goto propertyAccess
 	}
 	// literal
 	if p.peek().Type == TokenNumber || p.peek().Type == TokenString {
 		tok := p.consume()
-		base = &LiteralNode{Type: NodeLiteral, Text: tok.Text}
+		literal := &LiteralNode{Type: NodeLiteral, Text: tok.Text}
+		p.setTokenSpan(&literal.BaseNode, tok)
+		base = literal
 		goto propertyAccess
 	}
 	// data type
 	if p.peek().Type == TokenReservedDataType || p.peek().Type == TokenReservedDataTypePhrase {
 		tok := p.consume()
-		base = &DataTypeNode{Type: NodeDataType, Text: tok.Text, Raw: tok.Raw}
+		dataType := &DataTypeNode{Type: NodeDataType, Text: tok.Text, Raw: tok.Raw}
+		p.setTokenSpan(&dataType.BaseNode, tok)
+		base = dataType
 		goto arraySuffix
 	}
 	// keyword
 	if p.peek().Type == TokenReservedKeyword || p.peek().Type == TokenReservedKeywordPhrase || p.peek().Type == TokenReservedJoin {
 		tok := p.consume()
-		base = &KeywordNode{Type: NodeKeyword, TokenType: tok.Type, Text: tok.Text, Raw: tok.Raw}
+		base = p.keywordNode(tok)
 		goto arraySuffix
 	}
 
@@ -416,7 +529,9 @@ arraySuffix:
 			if err != nil {
 				return nil, false, err
 			}
-			base = &ArraySubscriptNode{Type: NodeArraySubscript, Array: base, Parenthesis: *parens}
+			subscript := &ArraySubscriptNode{Type: NodeArraySubscript, Array: base, Parenthesis: *parens}
+			p.setSpan(&subscript.BaseNode, start)
+			base = subscript
 		}
 	}
 
@@ -424,14 +539,16 @@ propertyAccess:
 	if base == nil {
 		return nil, false, nil
 	}
-	chain, err := p.parsePropertyAccessChain(base)
+	chain, err := p.parsePropertyAccessChain(base, start)
 	if err != nil {
 		return nil, false, err
 	}
 	return chain, true, nil
 }
 
-func (p *Parser) parsePropertyAccessChain(node AstNode) (AstNode, error) {
+// parsePropertyAccessChain parses the property accesses following node, which
+// starts at the offset start.
+func (p *Parser) parsePropertyAccessChain(node AstNode, start int) (AstNode, error) {
 	for {
 		next, commentCount := p.peekAfterComments()
 		if next.Type != TokenPropertyAccessOperator {
@@ -448,7 +565,9 @@ func (p *Parser) parsePropertyAccessChain(node AstNode) (AstNode, error) {
 			return nil, err
 		}
 		prop = addLeadingComments(prop, leading)
-		node = &PropertyAccessNode{Type: NodePropertyAccess, Object: node, Operator: opTok.Text, Property: prop}
+		access := &PropertyAccessNode{Type: NodePropertyAccess, Object: node, Operator: opTok.Text, Property: prop}
+		p.setSpan(&access.BaseNode, start)
+		node = access
 	}
 	return node, nil
 }
@@ -458,32 +577,41 @@ func (p *Parser) parsePropertyAccessProperty() (AstNode, error) {
 	switch tok.Type {
 	case TokenAsterisk:
 		p.consume()
-		return &AllColumnsAsteriskNode{Type: NodeAllColumnsAsterisk}, nil
+		asterisk := &AllColumnsAsteriskNode{Type: NodeAllColumnsAsterisk}
+		p.setTokenSpan(&asterisk.BaseNode, tok)
+		return asterisk, nil
 	case TokenArrayIdentifier, TokenArrayKeyword:
 		node, ok, err := p.parseArraySubscript()
 		if err != nil {
 			return nil, err
 		}
 		if !ok {
-			return nil, fmt.Errorf("Parse error: Invalid SQL")
+			return nil, p.errorAt(p.peek())
 		}
 		return node, nil
 	case TokenReservedFunctionName:
 		// Handle function calls in property access (e.g., sqlc.arg())
 		node, ok, err := p.parseFunctionCall()
-		if err != nil || !ok {
-			return nil, fmt.Errorf("Parse error: Invalid SQL")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, p.errorAt(p.peek())
 		}
 		return node, nil
 	case TokenIdentifier, TokenQuotedIdentifier, TokenVariable:
 		p.consume()
 		quoted := tok.Type != TokenIdentifier
-		return &IdentifierNode{Type: NodeIdentifier, Quoted: quoted, Text: tok.Text}, nil
-	case TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter:
+		ident := &IdentifierNode{Type: NodeIdentifier, Quoted: quoted, Text: tok.Text}
+		p.setTokenSpan(&ident.BaseNode, tok)
+		return ident, nil
+	case TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter, TokenTypedParameter:
 		p.consume()
-		return &ParameterNode{Type: NodeParameter, Key: tok.Key, Text: tok.Text}, nil
+		param := &ParameterNode{Type: NodeParameter, Key: tok.Key, Text: tok.Text}
+		p.setTokenSpan(&param.BaseNode, tok)
+		return param, nil
 	default:
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(tok)
 	}
 }
 
@@ -493,9 +621,11 @@ func (p *Parser) parseArraySubscript() (AstNode, bool, error) {
 	var array AstNode
 	switch tok.Type {
 	case TokenArrayIdentifier:
-		array = &IdentifierNode{Type: NodeIdentifier, Quoted: false, Text: tok.Text}
+		ident := &IdentifierNode{Type: NodeIdentifier, Quoted: false, Text: tok.Text}
+		p.setTokenSpan(&ident.BaseNode, tok)
+		array = ident
 	case TokenArrayKeyword:
-		array = &KeywordNode{Type: NodeKeyword, TokenType: tok.Type, Text: tok.Text, Raw: tok.Raw}
+		array = p.keywordNode(tok)
 	default:
 		return nil, false, nil
 	}
@@ -504,71 +634,77 @@ func (p *Parser) parseArraySubscript() (AstNode, bool, error) {
 	if err != nil {
 		return nil, false, err
 	}
-	return &ArraySubscriptNode{Type: NodeArraySubscript, Array: array, Parenthesis: *parens}, true, nil
+	subscript := &ArraySubscriptNode{Type: NodeArraySubscript, Array: array, Parenthesis: *parens}
+	p.setSpan(&subscript.BaseNode, tok.Start)
+	return subscript, true, nil
 }
 
 func (p *Parser) parseFunctionCall() (AstNode, bool, error) {
 	nameTok := p.consume()
 	trailing := p.parseComments()
-	nameKw := KeywordNode{Type: NodeKeyword, TokenType: nameTok.Type, Text: nameTok.Text, Raw: nameTok.Raw}
+	nameKw := *p.keywordNode(nameTok)
 	nameKw = addTrailingCommentsKeyword(nameKw, trailing)
 	parens, err := p.parseParenthesis()
 	if err != nil {
 		return nil, false, err
 	}
-	return &FunctionCallNode{Type: NodeFunctionCall, NameKw: nameKw, Parenthesis: *parens}, true, nil
+	call := &FunctionCallNode{Type: NodeFunctionCall, NameKw: nameKw, Parenthesis: *parens}
+	p.setSpan(&call.BaseNode, nameTok.Start)
+	return call, true, nil
 }
 
 func (p *Parser) parseParameterizedDataType() (AstNode, bool, error) {
 	nameTok := p.consume()
 	trailing := p.parseComments()
 	dataType := DataTypeNode{Type: NodeDataType, Text: nameTok.Text, Raw: nameTok.Raw}
+	p.setTokenSpan(&dataType.BaseNode, nameTok)
 	dataType = addTrailingCommentsDataType(dataType, trailing)
 	parens, err := p.parseParenthesis()
 	if err != nil {
 		return nil, false, err
 	}
-	return &ParameterizedDataTypeNode{Type: NodeParameterizedDataType, DataType: dataType, Parenthesis: *parens}, true, nil
+	node := &ParameterizedDataTypeNode{Type: NodeParameterizedDataType, DataType: dataType, Parenthesis: *parens}
+	p.setSpan(&node.BaseNode, nameTok.Start)
+	return node, true, nil
 }
 
 func (p *Parser) parseParenthesis() (*ParenthesisNode, error) {
 	openTok := p.consume()
 	open := openTok.Text
 	var close string
-	if open == "(" {
+	var children []AstNode
+	var err error
+	switch open {
+	case "(":
 		close = ")"
-		children, err := p.parseExpressionsOrClauses(TokenCloseParen)
+		children, err = p.parseExpressionsOrClauses(TokenCloseParen)
 		if err != nil {
 			return nil, err
 		}
 		if p.peek().Type != TokenCloseParen || p.peek().Text != close {
-			return nil, fmt.Errorf("Parse error: Invalid SQL")
+			return nil, p.errorAt(p.peek(), close)
 		}
 		p.consume()
-		return &ParenthesisNode{Type: NodeParenthesis, Children: children, OpenParen: open, CloseParen: close}, nil
-	}
-	if open == "{" {
+	case "{":
 		close = "}"
-		children, err := p.parseFreeFormListUntilClose(close)
-		if err != nil {
-			return nil, err
-		}
-		return &ParenthesisNode{Type: NodeParenthesis, Children: children, OpenParen: open, CloseParen: close}, nil
-	}
-	if open == "[" {
+		children, err = p.parseFreeFormListUntilClose(close)
+	case "[":
 		close = "]"
-		children, err := p.parseFreeFormListUntilClose(close)
-		if err != nil {
-			return nil, err
-		}
-		return &ParenthesisNode{Type: NodeParenthesis, Children: children, OpenParen: open, CloseParen: close}, nil
+		children, err = p.parseFreeFormListUntilClose(close)
+	default:
+		return nil, p.errorAt(openTok)
 	}
-	return nil, fmt.Errorf("Parse error: Invalid SQL")
+	if err != nil {
+		return nil, err
+	}
+	parens := &ParenthesisNode{Type: NodeParenthesis, Children: children, OpenParen: open, CloseParen: close}
+	p.setSpan(&parens.BaseNode, openTok.Start)
+	return parens, nil
 }
 
 func (p *Parser) parseSquareBrackets() (*ParenthesisNode, error) {
 	if p.peek().Type != TokenOpenParen || p.peek().Text != "[" {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek(), "[")
 	}
 	return p.parseParenthesis()
 }
@@ -585,7 +721,7 @@ func (p *Parser) parseFreeFormListUntilClose(close string) ([]AstNode, error) {
 			return nil, err
 		}
 		if !ok {
-			return nil, fmt.Errorf("Parse error: Invalid SQL")
+			return nil, p.errorAt(p.peek(), close)
 		}
 		children = append(children, node)
 	}
@@ -602,7 +738,7 @@ func (p *Parser) parseBetweenPredicate() (*BetweenPredicateNode, error) {
 	trail := p.parseComments()
 	andTok := p.expect(TokenAnd)
 	if andTok.Type == "" {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek(), "AND")
 	}
 	leading2 := p.parseComments()
 	expr2, ok, err := p.parseAndlessExpression()
@@ -610,14 +746,16 @@ func (p *Parser) parseBetweenPredicate() (*BetweenPredicateNode, error) {
 		return nil, err
 	}
 	if !ok {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek())
 	}
-	betweenKw := KeywordNode{Type: NodeKeyword, TokenType: betweenTok.Type, Text: betweenTok.Text, Raw: betweenTok.Raw}
+	betweenKw := *p.keywordNode(betweenTok)
 	betweenKw = addTrailingCommentsKeyword(betweenKw, leading)
 	expr1 = addCommentsToArray(expr1, leading, trail)
-	andKw := KeywordNode{Type: NodeKeyword, TokenType: andTok.Type, Text: andTok.Text, Raw: andTok.Raw}
+	andKw := *p.keywordNode(andTok)
 	expr2Node := addLeadingComments(expr2, leading2)
-	return &BetweenPredicateNode{Type: NodeBetweenPredicate, BetweenKw: betweenKw, Expr1: expr1, AndKw: andKw, Expr2: []AstNode{expr2Node}}, nil
+	between := &BetweenPredicateNode{Type: NodeBetweenPredicate, BetweenKw: betweenKw, Expr1: expr1, AndKw: andKw, Expr2: []AstNode{expr2Node}}
+	p.setSpan(&between.BaseNode, betweenTok.Start)
+	return between, nil
 }
 
 func (p *Parser) parseAndlessExpressionChain() ([]AstNode, error) {
@@ -627,7 +765,7 @@ func (p *Parser) parseAndlessExpressionChain() ([]AstNode, error) {
 		return nil, err
 	}
 	if !ok {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek())
 	}
 	items = append(items, first)
 	for {
@@ -652,7 +790,7 @@ func (p *Parser) parseAndlessExpressionChain() ([]AstNode, error) {
 func (p *Parser) parseCaseExpression() (*CaseExpressionNode, error) {
 	caseTok := p.consume()
 	trailing := p.parseComments()
-	caseKw := KeywordNode{Type: NodeKeyword, TokenType: caseTok.Type, Text: caseTok.Text, Raw: caseTok.Raw}
+	caseKw := *p.keywordNode(caseTok)
 	caseKw = addTrailingCommentsKeyword(caseKw, trailing)
 
 	expr := []AstNode{}
@@ -686,10 +824,12 @@ func (p *Parser) parseCaseExpression() (*CaseExpressionNode, error) {
 	}
 	endTok := p.expect(TokenEnd)
 	if endTok.Type == "" {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek(), "END")
 	}
-	endKw := KeywordNode{Type: NodeKeyword, TokenType: endTok.Type, Text: endTok.Text, Raw: endTok.Raw}
-	return &CaseExpressionNode{Type: NodeCaseExpression, CaseKw: caseKw, EndKw: endKw, Expr: expr, Clauses: clauses}, nil
+	endKw := *p.keywordNode(endTok)
+	caseExpr := &CaseExpressionNode{Type: NodeCaseExpression, CaseKw: caseKw, EndKw: endKw, Expr: expr, Clauses: clauses}
+	p.setSpan(&caseExpr.BaseNode, caseTok.Start)
+	return caseExpr, nil
 }
 
 func (p *Parser) parseCaseWhen() (*CaseWhenNode, error) {
@@ -701,18 +841,20 @@ func (p *Parser) parseCaseWhen() (*CaseWhenNode, error) {
 	}
 	thenTok := p.expect(TokenThen)
 	if thenTok.Type == "" {
-		return nil, fmt.Errorf("Parse error: Invalid SQL")
+		return nil, p.errorAt(p.peek(), "THEN")
 	}
 	thenTrailing := p.parseComments()
 	result, err := p.parseExpressionChainTrailing()
 	if err != nil {
 		return nil, err
 	}
-	whenKw := KeywordNode{Type: NodeKeyword, TokenType: whenTok.Type, Text: whenTok.Text, Raw: whenTok.Raw}
+	whenKw := *p.keywordNode(whenTok)
 	whenKw = addTrailingCommentsKeyword(whenKw, trailing)
-	thenKw := KeywordNode{Type: NodeKeyword, TokenType: thenTok.Type, Text: thenTok.Text, Raw: thenTok.Raw}
+	thenKw := *p.keywordNode(thenTok)
 	thenKw = addTrailingCommentsKeyword(thenKw, thenTrailing)
-	return &CaseWhenNode{Type: NodeCaseWhen, WhenKw: whenKw, ThenKw: thenKw, Condition: cond, Result: result}, nil
+	when := &CaseWhenNode{Type: NodeCaseWhen, WhenKw: whenKw, ThenKw: thenKw, Condition: cond, Result: result}
+	p.setSpan(&when.BaseNode, whenTok.Start)
+	return when, nil
 }
 
 func (p *Parser) parseCaseElse() (*CaseElseNode, error) {
@@ -722,22 +864,85 @@ func (p *Parser) parseCaseElse() (*CaseElseNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	elseKw := KeywordNode{Type: NodeKeyword, TokenType: elseTok.Type, Text: elseTok.Text, Raw: elseTok.Raw}
+	elseKw := *p.keywordNode(elseTok)
 	elseKw = addTrailingCommentsKeyword(elseKw, trailing)
-	return &CaseElseNode{Type: NodeCaseElse, ElseKw: elseKw, Result: result}, nil
+	caseElse := &CaseElseNode{Type: NodeCaseElse, ElseKw: elseKw, Result: result}
+	p.setSpan(&caseElse.BaseNode, elseTok.Start)
+	return caseElse, nil
+}
+
+func (p *Parser) parseBlock() (*BlockNode, error) {
+	start := p.peek().Start
+	sections := []*BlockSectionNode{}
+	for len(sections) == 0 || p.peek().Type == TokenBlockSection {
+		section, err := p.parseBlockSection()
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+	endTok := p.expect(TokenBlockEnd)
+	if endTok.Type == "" {
+		return nil, p.errorAt(p.peek(), "END")
+	}
+	endKw := *p.keywordNode(endTok)
+	var label *IdentifierNode
+	if p.peek().Type == TokenIdentifier || p.peek().Type == TokenQuotedIdentifier {
+		tok := p.consume()
+		label = &IdentifierNode{Type: NodeIdentifier, Quoted: tok.Type != TokenIdentifier, Text: tok.Text}
+		p.setTokenSpan(&label.BaseNode, tok)
+	}
+	block := &BlockNode{Type: NodeBlock, Sections: sections, EndKw: endKw, Label: label}
+	p.setSpan(&block.BaseNode, start)
+	return block, nil
+}
+
+// parseBlockSection parses the statements following DECLARE, BEGIN or
+// EXCEPTION up to the next section keyword or the END of the block.
+func (p *Parser) parseBlockSection() (*BlockSectionNode, error) {
+	nameTok := p.consume()
+	trailing := p.parseComments()
+	nameKw := *p.keywordNode(nameTok)
+	nameKw = addTrailingCommentsKeyword(nameKw, trailing)
+
+	statements := []*StatementNode{}
+	for !p.isStop(TokenBlockSection, TokenBlockEnd, TokenEOF) {
+		start := p.peek().Start
+		children, err := p.parseExpressionsOrClauses(TokenDelimiter, TokenBlockSection, TokenBlockEnd, TokenEOF)
+		if err != nil {
+			return nil, err
+		}
+		hasSemicolon := false
+		if p.peek().Type == TokenDelimiter {
+			hasSemicolon = true
+			p.consume()
+		} else if !p.isStop(TokenBlockSection, TokenBlockEnd, TokenEOF) {
+			return nil, p.errorAt(p.peek(), ";")
+		}
+		stmt := &StatementNode{Type: NodeStatement, Children: children, HasSemicolon: hasSemicolon}
+		p.setSpan(&stmt.BaseNode, start)
+		statements = append(statements, stmt)
+	}
+	section := &BlockSectionNode{Type: NodeBlockSection, NameKw: nameKw, Statements: statements}
+	p.setSpan(&section.BaseNode, nameTok.Start)
+	return section, nil
 }
 
 func (p *Parser) parseCommentNode() AstNode {
 	tok := p.consume()
 	switch tok.Type {
 	case TokenLineComment:
-		return &LineCommentNode{Type: NodeLineComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
-	case TokenBlockComment:
-		return &BlockCommentNode{Type: NodeBlockComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		comment := &LineCommentNode{Type: NodeLineComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		p.setTokenSpan(&comment.BaseNode, tok)
+		return comment
 	case TokenDisableComment:
-		return &DisableCommentNode{Type: NodeDisableComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		comment := &DisableCommentNode{Type: NodeDisableComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		p.setTokenSpan(&comment.BaseNode, tok)
+		return comment
 	default:
-		return &BlockCommentNode{Type: NodeBlockComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		comment := &BlockCommentNode{Type: NodeBlockComment, Text: tok.Text, PrecedingWhitespace: tok.PrecedingWhitespace}
+		p.setTokenSpan(&comment.BaseNode, tok)
+		return comment
 	}
 }
 
@@ -789,11 +994,11 @@ func (p *Parser) canStartExpression(tok Token) bool {
 		TokenOpenParen,
 		TokenOperator,
 		TokenIdentifier, TokenQuotedIdentifier, TokenVariable,
-		TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter,
+		TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter, TokenTypedParameter,
 		TokenNumber, TokenString,
 		TokenReservedDataType, TokenReservedDataTypePhrase,
 		TokenReservedKeyword, TokenReservedKeywordPhrase, TokenReservedJoin,
-		TokenBetween, TokenCase,
+		TokenBetween, TokenCase, TokenBlockStart,
 		TokenWhen, TokenThen, TokenElse, TokenEnd,
 		TokenComma,
 		TokenLineComment, TokenBlockComment, TokenDisableComment:
@@ -811,11 +1016,11 @@ func (p *Parser) canStartAndlessExpression(tok Token) bool {
 		TokenOpenParen,
 		TokenOperator,
 		TokenIdentifier, TokenQuotedIdentifier, TokenVariable,
-		TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter,
+		TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter, TokenTypedParameter,
 		TokenNumber, TokenString,
 		TokenReservedDataType, TokenReservedDataTypePhrase,
 		TokenReservedKeyword, TokenReservedKeywordPhrase, TokenReservedJoin,
-		TokenBetween, TokenCase,
+		TokenBetween, TokenCase, TokenBlockStart,
 		TokenWhen, TokenThen, TokenElse, TokenEnd,
 		TokenComma,
 		TokenLineComment, TokenBlockComment, TokenDisableComment:
@@ -866,7 +1071,7 @@ func (p *Parser) expect(t TokenType) Token {
 
 func (p *Parser) isParameterToken(tok Token) bool {
 	switch tok.Type {
-	case TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter:
+	case TokenNamedParameter, TokenQuotedParameter, TokenNumberedParameter, TokenPositionalParameter, TokenCustomParameter, TokenTypedParameter:
 		return true
 	default:
 		return false
@@ -902,6 +1107,8 @@ func addLeadingComments(node AstNode, comments []CommentNode) AstNode {
 		n.LeadingComments = comments
 	case *CaseElseNode:
 		n.LeadingComments = comments
+	case *BlockNode:
+		n.LeadingComments = comments
 	case *LimitClauseNode:
 		n.LeadingComments = comments
 	case *AllColumnsAsteriskNode:
@@ -957,6 +1164,8 @@ func addTrailingComments(node AstNode, comments []CommentNode) AstNode {
 		n.TrailingComments = comments
 	case *CaseElseNode:
 		n.TrailingComments = comments
+	case *BlockNode:
+		n.TrailingComments = comments
 	case *LimitClauseNode:
 		n.TrailingComments = comments
 	case *AllColumnsAsteriskNode:
@@ -0,0 +1,265 @@
+package sqlformatter
+
+import (
+	"regexp"
+	"strings"
+
+	plsql "sql-formatter-go/languages/plsql"
+)
+
+var plsqlReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT | UNIQUE]",
+}
+
+var plsqlReservedClausesPhrases = []string{
+	"WITH",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"PARTITION BY",
+	"ORDER [SIBLINGS] BY",
+	"OFFSET",
+	"FETCH {FIRST | NEXT}",
+	"FOR UPDATE [OF]",
+	"INSERT [INTO | ALL INTO]",
+	"VALUES",
+	"SET",
+	"MERGE [INTO]",
+	"WHEN [NOT] MATCHED [THEN]",
+	"UPDATE SET",
+	"RETURNING",
+}
+
+var plsqlStandardOnelineClausesPhrases = []string{
+	"CREATE [GLOBAL TEMPORARY | PRIVATE TEMPORARY | SHARDED | DUPLICATED | IMMUTABLE BLOCKCHAIN | BLOCKCHAIN | IMMUTABLE] TABLE",
+}
+
+var plsqlTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [NO FORCE | FORCE] [EDITIONING | EDITIONABLE | EDITIONABLE EDITIONING | NONEDITIONABLE] VIEW",
+	"CREATE MATERIALIZED VIEW",
+	"CREATE [OR REPLACE] [EDITIONABLE | NONEDITIONABLE] {PROCEDURE | FUNCTION | PACKAGE | PACKAGE BODY | TRIGGER | TYPE | TYPE BODY}",
+	"UPDATE [ONLY]",
+	"DELETE FROM [ONLY]",
+	"DROP TABLE",
+	"DROP {VIEW | MATERIALIZED VIEW | PROCEDURE | FUNCTION | PACKAGE | PACKAGE BODY | TRIGGER | TYPE | TYPE BODY | SEQUENCE}",
+	"ALTER TABLE",
+	"ADD",
+	"DROP {COLUMN | UNUSED COLUMNS | COLUMNS CONTINUE}",
+	"MODIFY",
+	"RENAME TO",
+	"RENAME COLUMN",
+	"TRUNCATE TABLE",
+	"SET SCHEMA",
+	"CONNECT BY [NOCYCLE]",
+	"START WITH",
+}
+
+var plsqlReservedSetOperationsPhrases = []string{
+	"UNION [ALL]",
+	"MINUS",
+	"INTERSECT",
+}
+
+var plsqlReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"NATURAL [INNER] JOIN",
+	"NATURAL {LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{CROSS | OUTER} APPLY",
+}
+
+var plsqlReservedKeywordPhrasesPhrases = []string{
+	"ON {UPDATE | DELETE} [SET NULL]",
+	"ON COMMIT",
+	"{ROWS | RANGE} BETWEEN",
+}
+
+var PlsqlDialect = DialectOptions{
+	Name: "plsql",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(plsqlReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(plsqlReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(plsqlStandardOnelineClausesPhrases)...), ExpandPhrases(plsqlTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(plsqlReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(plsqlReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(plsqlReservedKeywordPhrasesPhrases),
+		ReservedKeywords:       plsql.Keywords,
+		ReservedDataTypes:      plsql.DataTypes,
+		ReservedFunctionNames:  plsql.Functions,
+		StringTypes: []QuoteType{
+			PrefixedQuoteType{Quote: PlainQuoteType("''-qq"), Prefixes: []string{"N"}},
+			PrefixedQuoteType{Quote: PlainQuoteType("q''"), Prefixes: []string{"N"}},
+		},
+		IdentTypes: []QuoteType{PlainQuoteType("\"\"-qq")},
+		IdentChars: &IdentChars{Rest: "$#"},
+		// SQL*Plus substitution variables: &name, &&name
+		VariableTypes: []VariableType{RegexPattern{Regex: `&{1,2}[A-Za-z][A-Za-z0-9_$#]*`}},
+		ParamTypes:    &ParamTypes{Numbered: []string{":"}, Named: []string{":"}},
+		Operators:     []string{"**", ":=", "%", "~=", "^=", ">>", "<<", "=>", "@", "||"},
+		PostProcess:   plsqlPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		AlwaysDenseOperators:  []string{"@"},
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(plsqlStandardOnelineClausesPhrases)...), ExpandPhrases(plsqlTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(plsqlTabularOnelineClausesPhrases),
+	},
+}
+
+func plsqlPostProcess(tokens []Token) []Token {
+	return detectPlsqlBlocks(detectSlashTerminators(tokens))
+}
+
+// detectSlashTerminators turns a / on a line of its own, which SQL*Plus uses
+// to run the preceding statement or PL/SQL block, into a batch separator.
+func detectSlashTerminators(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenOperator || token.Text != "/" || !startsLine(token, i) {
+			continue
+		}
+		if i+1 < len(tokens) && !startsLine(tokens[i+1], i+1) {
+			continue
+		}
+		tokens[i].Type = TokenBatchSeparator
+	}
+	return tokens
+}
+
+var plsqlRoutineHeaderRegex = regexp.MustCompile(`^CREATE (OR REPLACE )?((NON)?EDITIONABLE )?(PROCEDURE|FUNCTION|PACKAGE|PACKAGE BODY|TRIGGER|TYPE BODY)$`)
+
+// plsqlBlock tracks one open DECLARE/BEGIN/AS ... END block while scanning.
+type plsqlBlock struct {
+	// indexes of the tokens retyped for this block, reverted if it never ends
+	tokens []int
+	// declaring is true until the BEGIN that starts the executable part
+	declaring bool
+	// cases holds the indexes of CASE tokens still waiting for their END
+	cases []int
+}
+
+// detectPlsqlBlocks marks the keywords that open, divide and close PL/SQL
+// blocks, so that the parser keeps the semicolons inside a block from ending
+// the enclosing statement:
+//
+//   - DECLARE and BEGIN open a block, as does the AS/IS that follows the
+//     header of a stored procedure, function, package or nested subprogram;
+//   - BEGIN after declarations and EXCEPTION start a new block section;
+//   - END closes the block, unless it belongs to END IF, END LOOP or a CASE.
+//
+// Blocks that are never closed are left alone and parse as plain keywords.
+// The opening keyword of those still open at the end of the input is marked
+// as an unclosed block, as more input could close them.
+func detectPlsqlBlocks(tokens []Token) []Token {
+	var (
+		stack         []*plsqlBlock
+		pendingHeader bool
+		parenDepth    int
+		topCases      []int
+	)
+	revertOpenBlocks := func(atEnd bool) {
+		for _, block := range stack {
+			for _, j := range block.tokens {
+				tokens[j].Type = TokenReservedKeyword
+			}
+			// only the end of the input may cut a block short
+			tokens[block.tokens[0]].unclosedBlock = atEnd
+		}
+		stack = nil
+	}
+	for i := range tokens {
+		token := tokens[i]
+		var top *plsqlBlock
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		text := strings.ToUpper(token.Text)
+		switch {
+		case token.Type == TokenBatchSeparator:
+			revertOpenBlocks(false)
+			pendingHeader = false
+		case token.Type == TokenOpenParen:
+			parenDepth++
+		case token.Type == TokenCloseParen:
+			parenDepth--
+		case token.Type == TokenDelimiter:
+			pendingHeader = false
+		case token.Type == TokenReservedClause && plsqlRoutineHeaderRegex.MatchString(text):
+			// trigger bodies start with DECLARE or BEGIN, and their header
+			// may contain AS (REFERENCING NEW AS n)
+			pendingHeader = !strings.HasSuffix(text, "TRIGGER")
+		case token.Type == TokenCase:
+			if top != nil {
+				top.cases = append(top.cases, i)
+			} else {
+				topCases = append(topCases, i)
+			}
+		case token.Type == TokenEnd:
+			next := nextNonCommentToken(tokens, i)
+			nextText := strings.ToUpper(next.Text)
+			if nextText == "IF" || nextText == "LOOP" {
+				continue
+			}
+			cases := &topCases
+			if top != nil {
+				cases = &top.cases
+			}
+			if len(*cases) > 0 {
+				caseIndex := (*cases)[len(*cases)-1]
+				*cases = (*cases)[:len(*cases)-1]
+				if nextText == "CASE" {
+					// a CASE statement rather than a CASE expression, so
+					// neither its CASE nor the one after END is parsed
+					tokens[caseIndex].Type = TokenReservedKeyword
+					for j := i + 1; j < len(tokens); j++ {
+						if !isComment(tokens[j]) {
+							tokens[j].Type = TokenReservedKeyword
+							break
+						}
+					}
+				}
+				continue
+			}
+			if top != nil {
+				tokens[i].Type = TokenBlockEnd
+				stack = stack[:len(stack)-1]
+			}
+		case parenDepth > 0 || !IsReserved(token.Type):
+			// block keywords never appear inside parentheses
+		case text == "DECLARE":
+			pendingHeader = false
+			tokens[i].Type = TokenBlockStart
+			stack = append(stack, &plsqlBlock{tokens: []int{i}, declaring: true})
+		case text == "BEGIN":
+			pendingHeader = false
+			if top != nil && top.declaring {
+				tokens[i].Type = TokenBlockSection
+				top.tokens = append(top.tokens, i)
+				top.declaring = false
+			} else {
+				tokens[i].Type = TokenBlockStart
+				stack = append(stack, &plsqlBlock{tokens: []int{i}})
+			}
+		case text == "EXCEPTION" && top != nil && !top.declaring && startsPlsqlStatement(tokens, i):
+			tokens[i].Type = TokenBlockSection
+			top.tokens = append(top.tokens, i)
+		case (text == "PROCEDURE" || text == "FUNCTION") && top != nil && top.declaring:
+			pendingHeader = true
+		case (text == "AS" || text == "IS") && pendingHeader:
+			pendingHeader = false
+			next := strings.ToUpper(nextNonCommentToken(tokens, i).Text)
+			if next == "LANGUAGE" || next == "EXTERNAL" {
+				// call specification, there is no PL/SQL body
+				continue
+			}
+			tokens[i].Type = TokenBlockStart
+			stack = append(stack, &plsqlBlock{tokens: []int{i}, declaring: true})
+		}
+	}
+	revertOpenBlocks(true)
+	return tokens
+}
+
+func startsPlsqlStatement(tokens []Token, index int) bool {
+	prev := prevNonCommentToken(tokens, index)
+	return prev.Type == "" || prev.Type == TokenDelimiter || prev.Type == TokenBlockStart || prev.Type == TokenBlockSection
+}
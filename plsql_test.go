@@ -0,0 +1,182 @@
+package sqlformatter
+
+import "testing"
+
+func TestPlsqlFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguagePlsql, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguagePlsql, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, Materialized: true})
+	supportsCreateTable(t, format, createTableConfig{})
+	supportsDropTable(t, format, dropTableConfig{})
+	supportsConstraints(t, format, []string{"SET NULL", "CASCADE", "NO ACTION"})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, Modify: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format, deleteFromConfig{})
+	supportsInsertInto(t, format, insertIntoConfig{})
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "N''"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq"})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{SupportsUsing: true, SupportsApply: true})
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "MINUS", "INTERSECT"})
+	supportsOperators(t, format, []string{"**", ":=", "%", "~=", "^=", ">>", "<<", "=>", "||"}, operatorConfig{Any: true})
+	supportsParams(t, format, paramConfig{Numbered: []string{":"}, Named: []string{":"}})
+	supportsLimiting(t, format, limitingConfig{Offset: true, FetchFirst: true, FetchNext: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("supports q-quoted strings", func(t *testing.T) {
+		result := format("SELECT q'[it's]', q'{a}', nq'<b>' FROM dual")
+		expected := dedent(`
+			SELECT
+			  q'[it's]',
+			  q'{a}',
+			  nq'<b>'
+			FROM
+			  dual
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports substitution and bind variables", func(t *testing.T) {
+		result := format("SELECT * FROM t WHERE a = &val AND b = &&other AND c = :bind")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  t
+			WHERE
+			  a = &val
+			  AND b = &&other
+			  AND c = :bind
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats hierarchical queries", func(t *testing.T) {
+		result := format("SELECT id FROM emp START WITH mgr IS NULL CONNECT BY PRIOR id = mgr")
+		expected := dedent(`
+			SELECT
+			  id
+			FROM
+			  emp
+			START WITH mgr IS NULL
+			CONNECT BY PRIOR id = mgr
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats MERGE", func(t *testing.T) {
+		result := format("MERGE INTO t USING s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET t.a = s.a WHEN NOT MATCHED THEN INSERT (id, a) VALUES (s.id, s.a);")
+		expected := dedent(`
+			MERGE INTO
+			  t USING s ON (t.id = s.id)
+			WHEN MATCHED THEN
+			UPDATE SET
+			  t.a = s.a
+			WHEN NOT MATCHED THEN
+			INSERT
+			  (id, a)
+			VALUES
+			  (s.id, s.a);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps statements inside an anonymous block together", func(t *testing.T) {
+		result := format("BEGIN UPDATE t SET a = 1; COMMIT; END;\n/\nSELECT 1 FROM dual;")
+		expected := dedent(`
+			BEGIN
+			  UPDATE t
+			  SET
+			    a = 1;
+			  COMMIT;
+			END;
+			/
+
+			SELECT
+			  1
+			FROM
+			  dual;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats DECLARE block with exception handler", func(t *testing.T) {
+		result := format("DECLARE x NUMBER := 1; BEGIN x := CASE WHEN x > 0 THEN 1 ELSE 2 END; IF x > 1 THEN NULL; END IF; EXCEPTION WHEN OTHERS THEN NULL; END;")
+		expected := dedent(`
+			DECLARE
+			  x NUMBER := 1;
+			BEGIN
+			  x := CASE
+			    WHEN x > 0 THEN 1
+			    ELSE 2
+			  END;
+			  IF x > 1 THEN NULL;
+			  END IF;
+			EXCEPTION
+			  WHEN OTHERS THEN NULL;
+			END;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats CASE statement closed by END CASE", func(t *testing.T) {
+		result := format("BEGIN CASE x WHEN 1 THEN y := 2; ELSE y := 3; END CASE; END;")
+		expected := dedent(`
+			BEGIN
+			  CASE x WHEN 1 THEN y := 2;
+			  ELSE y := 3;
+			  END CASE;
+			END;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats stored procedure body", func(t *testing.T) {
+		result := format("CREATE OR REPLACE PROCEDURE p (a IN NUMBER) AS v NUMBER; BEGIN v := a * 2; DELETE FROM t WHERE id = v; END p;\n/")
+		expected := dedent(`
+			CREATE
+			OR REPLACE PROCEDURE p (a IN NUMBER) AS
+			  v NUMBER;
+			BEGIN
+			  v := a * 2;
+			  DELETE FROM t
+			  WHERE
+			    id = v;
+			END p;
+			/
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("treats / as division when not alone on its line", func(t *testing.T) {
+		result := format("SELECT a / b FROM t;")
+		expected := dedent(`
+			SELECT
+			  a / b
+			FROM
+			  t;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("falls back to plain statements for unterminated blocks", func(t *testing.T) {
+		result := format("BEGIN SELECT 1 FROM dual;")
+		expected := dedent(`
+			BEGIN
+			SELECT
+			  1
+			FROM
+			  dual;
+		`)
+		assertEqual(t, result, expected)
+	})
+}
@@ -1,6 +1,7 @@
 package sqlformatter
 
 import (
+	"regexp"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -11,8 +12,21 @@ type QuoteMatcher struct {
 	forIdentifiers bool
 }
 
+// NewQuoteMatcher returns a matcher for the given quote types. RegexPattern
+// types are compiled here, once, rather than at every position tried.
 func NewQuoteMatcher(types []QuoteType) *QuoteMatcher {
-	return &QuoteMatcher{quoteTypes: types}
+	compiled := make([]QuoteType, len(types))
+	for i, qt := range types {
+		switch v := qt.(type) {
+		case RegexPattern:
+			compiled[i] = PatternToRegex(v.Regex, false)
+		case *RegexPattern:
+			compiled[i] = PatternToRegex(v.Regex, false)
+		default:
+			compiled[i] = qt
+		}
+	}
+	return &QuoteMatcher{quoteTypes: compiled}
 }
 
 func (m *QuoteMatcher) Match(input string, index int) (string, bool) {
@@ -32,10 +46,8 @@ func matchQuoteType(input string, index int, qt QuoteType) (string, bool) {
 		return matchPrefixedQuote(input, index, v)
 	case *PrefixedQuoteType:
 		return matchPrefixedQuote(input, index, *v)
-	case RegexPattern:
-		return matchRegexPattern(input, index, v.Regex)
-	case *RegexPattern:
-		return matchRegexPattern(input, index, v.Regex)
+	case *regexp.Regexp:
+		return matchRegexp(input, index, v)
 	case string:
 		return matchPlainQuote(input, index, v)
 	default:
@@ -63,8 +75,7 @@ func matchPrefixedQuote(input string, index int, qt PrefixedQuoteType) (string,
 	return "", false
 }
 
-func matchRegexPattern(input string, index int, pattern string) (string, bool) {
-	re := PatternToRegex(pattern, false)
+func matchRegexp(input string, index int, re *regexp.Regexp) (string, bool) {
 	loc := re.FindStringIndex(input[index:])
 	if loc == nil || loc[0] != 0 {
 		return "", false
@@ -96,11 +107,47 @@ func matchPlainQuote(input string, index int, quote string) (string, bool) {
 		return matchQuotedString(input, index, '`', true, false)
 	case "[]":
 		return matchBracketQuoted(input, index)
+	case "'''..'''":
+		return matchTripleQuoted(input, index, '\'')
+	case `""".."""`:
+		return matchTripleQuoted(input, index, '"')
+	case "q''":
+		return matchAlternativeQuoted(input, index)
 	default:
 		return "", false
 	}
 }
 
+// matchAlternativeQuoted matches Oracle's q'<delim>...<delim>' strings. The
+// opening delimiter may be any non-space character; brackets close with their
+// counterpart, so q'[it's]' and q'{a}b}' are both valid.
+func matchAlternativeQuoted(input string, index int) (string, bool) {
+	if index+2 >= len(input) || (input[index] != 'q' && input[index] != 'Q') || input[index+1] != '\'' {
+		return "", false
+	}
+	open, size := utf8.DecodeRuneInString(input[index+2:])
+	if open == utf8.RuneError || unicode.IsSpace(open) {
+		return "", false
+	}
+	closing := open
+	switch open {
+	case '[':
+		closing = ']'
+	case '(':
+		closing = ')'
+	case '{':
+		closing = '}'
+	case '<':
+		closing = '>'
+	}
+	body := index + 2 + size
+	end := strings.Index(input[body:], string(closing)+"'")
+	if end < 0 {
+		return "", false
+	}
+	return input[index : body+end+utf8.RuneLen(closing)+1], true
+}
+
 func matchQuotedString(input string, index int, quoteChar byte, allowRepeatQuote bool, allowBackslash bool) (string, bool) {
 	if index >= len(input) || input[index] != quoteChar {
 		return "", false
@@ -124,6 +171,26 @@ func matchQuotedString(input string, index int, quoteChar byte, allowRepeatQuote
 	return "", false
 }
 
+// matchTripleQuoted matches BigQuery's triple-quoted strings, delimited by three
+// single or three double quotes on each side. Backslash escapes are honoured
+// and the contents may span multiple lines.
+func matchTripleQuoted(input string, index int, quoteChar byte) (string, bool) {
+	delim := strings.Repeat(string(quoteChar), 3)
+	if !strings.HasPrefix(input[index:], delim) {
+		return "", false
+	}
+	for i := index + len(delim); i < len(input); i++ {
+		if input[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(input[i:], delim) {
+			return input[index : i+len(delim)], true
+		}
+	}
+	return "", false
+}
+
 func matchBracketQuoted(input string, index int) (string, bool) {
 	if index >= len(input) || input[index] != '[' {
 		return "", false
@@ -0,0 +1,45 @@
+package sqlformatter
+
+import redshift "sql-formatter-go/languages/redshift"
+
+var redshiftTabularOnelineClausesPhrases = []string{
+	"DISTSTYLE",
+	"DISTKEY",
+	"[COMPOUND | INTERLEAVED] SORTKEY",
+	"UNLOAD",
+	"IAM_ROLE",
+	"CREATE EXTERNAL {SCHEMA | TABLE}",
+	"CREATE DATASHARE",
+	"ALTER DATASHARE",
+}
+
+// RedshiftDialect is the PostgreSQL dialect with the Redshift table
+// attributes, UNLOAD/COPY options and data types added on top.
+var RedshiftDialect = ExtendDialect(PostgresqlDialect, DialectOverrides{
+	Name:                     "redshift",
+	AddTabularOnelineClauses: redshiftTabularOnelineClausesPhrases,
+	AddKeywords:              redshift.Keywords,
+	AddDataTypes:             redshift.DataTypes,
+	AddFunctions:             redshift.Functions,
+	PostProcess:              redshiftPostProcess,
+})
+
+// redshiftPostProcess keeps DISTKEY and SORTKEY as plain keywords when they
+// are column attributes inside the column list of CREATE TABLE; only the
+// table-level attributes start a new line.
+func redshiftPostProcess(tokens []Token) []Token {
+	depth := 0
+	for i, token := range tokens {
+		switch token.Type {
+		case TokenOpenParen:
+			depth++
+		case TokenCloseParen:
+			depth--
+		case TokenReservedClause:
+			if depth > 0 && (token.Text == "DISTKEY" || token.Text == "SORTKEY") {
+				tokens[i].Type = TokenReservedKeyword
+			}
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,71 @@
+package sqlformatter
+
+import "testing"
+
+func TestRedshiftFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageRedshift, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageRedshift, query, cfg...)
+	}
+
+	behavesLikePostgresqlFormatter(t, format)
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, Materialized: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "U&''", "X''", "B''", "E''", "$$"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq", "U&\"\""})
+	supportsJoin(t, format)
+	supportsSetOperations(t, format)
+	supportsParams(t, format, paramConfig{Numbered: []string{"$"}})
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true, FetchFirst: true, FetchNext: true})
+
+	t.Run("formats table distribution and sort attributes", func(t *testing.T) {
+		result := format("CREATE TABLE sales (id INT ENCODE az64 DISTKEY, ts TIMESTAMP SORTKEY, doc SUPER) DISTSTYLE KEY DISTKEY (id) COMPOUND SORTKEY (ts, id);")
+		expected := dedent(`
+			CREATE TABLE sales (
+			  id INT ENCODE az64 DISTKEY,
+			  ts TIMESTAMP SORTKEY,
+			  doc SUPER
+			)
+			DISTSTYLE KEY
+			DISTKEY (id)
+			COMPOUND SORTKEY (ts, id);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats UNLOAD", func(t *testing.T) {
+		result := format("UNLOAD ('SELECT * FROM sales') TO 's3://bucket/prefix' IAM_ROLE 'arn:aws:iam::1:role/r' FORMAT AS PARQUET;")
+		expected := dedent(`
+			UNLOAD ('SELECT * FROM sales') TO 's3://bucket/prefix'
+			IAM_ROLE 'arn:aws:iam::1:role/r' FORMAT AS PARQUET;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats COPY with IAM_ROLE", func(t *testing.T) {
+		result := format("COPY sales FROM 's3://bucket/data' IAM_ROLE default FORMAT AS CSV IGNOREHEADER 1;")
+		expected := dedent(`
+			COPY sales
+			FROM
+			  's3://bucket/data'
+			IAM_ROLE default FORMAT AS CSV IGNOREHEADER 1;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports SUPER navigation", func(t *testing.T) {
+		result := format("SELECT c.doc.customer.name, c.doc.orders[0].id FROM sales c")
+		expected := dedent(`
+			SELECT
+			  c.doc.customer.name,
+			  c.doc.orders[0].id
+			FROM
+			  sales c
+		`)
+		assertEqual(t, result, expected)
+	})
+}
@@ -0,0 +1,192 @@
+package sqlformatter
+
+// Script is a parsed SQL text: the statements the formatter would format, in
+// source order.
+type Script struct {
+	Statements []*StatementNode
+}
+
+func (s *Script) Kind() NodeType { return NodeScript }
+
+// ParseSQL parses query with the given dialect, such as PostgresqlDialect,
+// using the same parser as the formatter.
+func ParseSQL(query string, dialect DialectOptions) (*Script, error) {
+	d := CreateDialect(dialect)
+	parser := NewParser(d.Tokenizer)
+	statements, err := parser.Parse(query, d.Tokenizer, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Script{Statements: statements}, nil
+}
+
+// A Visitor's Visit method is invoked for each node encountered by Walk. If
+// the result visitor w is not nil, Walk visits each of the children of node
+// with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node AstNode) (w Visitor)
+}
+
+// Walk traverses a syntax tree in depth-first, source order, like go/ast's
+// Walk. Keyword, data type and parenthesis fields held by value are visited
+// through pointers into their parent node. Leading comments are visited
+// before a node's children and trailing comments after them.
+func Walk(v Visitor, node AstNode) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *Script:
+		for _, stmt := range n.Statements {
+			Walk(v, stmt)
+		}
+	case *StatementNode:
+		walkComments(v, n.LeadingComments)
+		walkList(v, n.Children)
+		walkComments(v, n.TrailingComments)
+	case *ClauseNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.NameKw)
+		walkList(v, n.Children)
+		walkComments(v, n.TrailingComments)
+	case *SetOperationNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.NameKw)
+		walkList(v, n.Children)
+		walkComments(v, n.TrailingComments)
+	case *FunctionCallNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.NameKw)
+		Walk(v, &n.Parenthesis)
+		walkComments(v, n.TrailingComments)
+	case *ParameterizedDataTypeNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.DataType)
+		Walk(v, &n.Parenthesis)
+		walkComments(v, n.TrailingComments)
+	case *ArraySubscriptNode:
+		walkComments(v, n.LeadingComments)
+		if n.Array != nil {
+			Walk(v, n.Array)
+		}
+		Walk(v, &n.Parenthesis)
+		walkComments(v, n.TrailingComments)
+	case *PropertyAccessNode:
+		walkComments(v, n.LeadingComments)
+		if n.Object != nil {
+			Walk(v, n.Object)
+		}
+		if n.Property != nil {
+			Walk(v, n.Property)
+		}
+		walkComments(v, n.TrailingComments)
+	case *ParenthesisNode:
+		walkComments(v, n.LeadingComments)
+		walkList(v, n.Children)
+		walkComments(v, n.TrailingComments)
+	case *BetweenPredicateNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.BetweenKw)
+		walkList(v, n.Expr1)
+		Walk(v, &n.AndKw)
+		walkList(v, n.Expr2)
+		walkComments(v, n.TrailingComments)
+	case *CaseExpressionNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.CaseKw)
+		walkList(v, n.Expr)
+		walkList(v, n.Clauses)
+		Walk(v, &n.EndKw)
+		walkComments(v, n.TrailingComments)
+	case *CaseWhenNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.WhenKw)
+		walkList(v, n.Condition)
+		Walk(v, &n.ThenKw)
+		walkList(v, n.Result)
+		walkComments(v, n.TrailingComments)
+	case *CaseElseNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.ElseKw)
+		walkList(v, n.Result)
+		walkComments(v, n.TrailingComments)
+	case *BlockNode:
+		walkComments(v, n.LeadingComments)
+		for _, section := range n.Sections {
+			Walk(v, section)
+		}
+		Walk(v, &n.EndKw)
+		if n.Label != nil {
+			Walk(v, n.Label)
+		}
+		walkComments(v, n.TrailingComments)
+	case *BlockSectionNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.NameKw)
+		for _, stmt := range n.Statements {
+			Walk(v, stmt)
+		}
+		walkComments(v, n.TrailingComments)
+	case *LimitClauseNode:
+		walkComments(v, n.LeadingComments)
+		Walk(v, &n.LimitKw)
+		// Offset is only set for LIMIT offset, count, where it comes first.
+		walkList(v, n.Offset)
+		walkList(v, n.Count)
+		walkComments(v, n.TrailingComments)
+	case *AllColumnsAsteriskNode:
+		walkLeaf(v, n.BaseNode)
+	case *LiteralNode:
+		walkLeaf(v, n.BaseNode)
+	case *IdentifierNode:
+		walkLeaf(v, n.BaseNode)
+	case *DataTypeNode:
+		walkLeaf(v, n.BaseNode)
+	case *KeywordNode:
+		walkLeaf(v, n.BaseNode)
+	case *ParameterNode:
+		walkLeaf(v, n.BaseNode)
+	case *OperatorNode:
+		walkLeaf(v, n.BaseNode)
+	case *CommaNode:
+		walkLeaf(v, n.BaseNode)
+	case *LineCommentNode, *BlockCommentNode, *DisableCommentNode:
+		// comments have no children
+	}
+
+	v.Visit(nil)
+}
+
+func walkList(v Visitor, nodes []AstNode) {
+	for _, node := range nodes {
+		Walk(v, node)
+	}
+}
+
+func walkComments(v Visitor, comments []CommentNode) {
+	for _, comment := range comments {
+		Walk(v, comment)
+	}
+}
+
+func walkLeaf(v Visitor, base BaseNode) {
+	walkComments(v, base.LeadingComments)
+	walkComments(v, base.TrailingComments)
+}
+
+type inspector func(AstNode) bool
+
+func (f inspector) Visit(node AstNode) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses a syntax tree in the order of Walk, calling f(node) for
+// each node; if f returns true, Inspect visits the children of node, followed
+// by a call of f(nil).
+func Inspect(node AstNode, f func(AstNode) bool) {
+	Walk(inspector(f), node)
+}
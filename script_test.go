@@ -0,0 +1,146 @@
+package sqlformatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSQL(t *testing.T) {
+	t.Run("returns statements with their source offsets", func(t *testing.T) {
+		query := "SELECT a FROM t;\n  UPDATE t SET a = 1;"
+		script, err := ParseSQL(query, PostgresqlDialect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(script.Statements) != 2 {
+			t.Fatalf("expected 2 statements, got %d", len(script.Statements))
+		}
+		var got []string
+		for _, stmt := range script.Statements {
+			got = append(got, query[stmt.Start.Offset:stmt.End.Offset])
+		}
+		assertEqual(t, strings.Join(got, "|"), "SELECT a FROM t;|UPDATE t SET a = 1;")
+	})
+
+	t.Run("records the source span of every node", func(t *testing.T) {
+		query := "SELECT count(*), b.c, CASE WHEN x THEN 1 END\nFROM t\nORDER   BY arr[1];"
+		script, err := ParseSQL(query, PostgresqlDialect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var spans []string
+		Inspect(script.Statements[0], func(node AstNode) bool {
+			if node == nil {
+				return true
+			}
+			var base BaseNode
+			switch n := node.(type) {
+			case *StatementNode:
+				base = n.BaseNode
+			case *ClauseNode:
+				base = n.BaseNode
+			case *FunctionCallNode:
+				base = n.BaseNode
+			case *PropertyAccessNode:
+				base = n.BaseNode
+			case *CaseExpressionNode:
+				base = n.BaseNode
+			case *ArraySubscriptNode:
+				base = n.BaseNode
+			default:
+				return true
+			}
+			spans = append(spans, query[base.Start.Offset:base.End.Offset])
+			return true
+		})
+		expected := []string{
+			query,
+			"SELECT count(*), b.c, CASE WHEN x THEN 1 END",
+			"count(*)",
+			"b.c",
+			"CASE WHEN x THEN 1 END",
+			"FROM t",
+			"ORDER   BY arr[1]",
+			"arr[1]",
+		}
+		assertEqual(t, strings.Join(spans, "|"), strings.Join(expected, "|"))
+	})
+
+	t.Run("records lines and columns in runes", func(t *testing.T) {
+		query := "SELECT 'ä'\n  FROM t\nWHERE  'ö' = x"
+		script, err := ParseSQL(query, PostgresqlDialect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var positions []Position
+		Inspect(script, func(node AstNode) bool {
+			switch n := node.(type) {
+			case *LiteralNode:
+				positions = append(positions, n.Start, n.End)
+			case *IdentifierNode:
+				positions = append(positions, n.Start, n.End)
+			}
+			return true
+		})
+		expected := []Position{
+			{Offset: 7, Line: 1, Column: 8}, {Offset: 11, Line: 1, Column: 11},
+			{Offset: 19, Line: 2, Column: 8}, {Offset: 20, Line: 2, Column: 9},
+			{Offset: 28, Line: 3, Column: 8}, {Offset: 32, Line: 3, Column: 11},
+			{Offset: 35, Line: 3, Column: 14}, {Offset: 36, Line: 3, Column: 15},
+		}
+		if len(positions) != len(expected) {
+			t.Fatalf("expected %d positions, got %v", len(expected), positions)
+		}
+		for i := range expected {
+			if positions[i] != expected[i] {
+				t.Errorf("position %d: expected %+v, got %+v", i, expected[i], positions[i])
+			}
+			line, col := lineColFromIndex(query, positions[i].Offset)
+			if positions[i].Line != line || positions[i].Column != col {
+				t.Errorf("position %d: lineColFromIndex gives %d:%d", i, line, col)
+			}
+		}
+	})
+
+	t.Run("walks the tree in source order", func(t *testing.T) {
+		script, err := ParseSQL("SELECT count(*), b.c FROM t WHERE x BETWEEN 1 AND 2 -- done", PostgresqlDialect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var kinds []string
+		Inspect(script, func(node AstNode) bool {
+			if node != nil {
+				kinds = append(kinds, string(node.Kind()))
+			}
+			return true
+		})
+		expected := "script statement clause keyword function_call keyword parenthesis operator comma " +
+			"property_access identifier identifier clause keyword identifier clause keyword identifier " +
+			"between_predicate keyword literal keyword literal line_comment"
+		assertEqual(t, strings.Join(kinds, " "), expected)
+	})
+
+	t.Run("can prune subtrees", func(t *testing.T) {
+		script, err := ParseSQL("SELECT (SELECT inner_col FROM u) FROM t", PostgresqlDialect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var identifiers []string
+		Inspect(script, func(node AstNode) bool {
+			switch n := node.(type) {
+			case *ParenthesisNode:
+				return false
+			case *IdentifierNode:
+				identifiers = append(identifiers, n.Text)
+			}
+			return true
+		})
+		assertEqual(t, strings.Join(identifiers, ","), "t")
+	})
+
+	t.Run("reports parse errors", func(t *testing.T) {
+		if _, err := ParseSQL("SELECT 'unterminated", PostgresqlDialect); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package sqlformatter
+
+import snowflake "sql-formatter-go/languages/snowflake"
+
+var snowflakeReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT]",
+}
+
+var snowflakeReservedClausesPhrases = []string{
+	"WITH [RECURSIVE]",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"PARTITION BY",
+	"ORDER BY",
+	"QUALIFY",
+	"LIMIT",
+	"OFFSET",
+	"FETCH [FIRST | NEXT]",
+	"INSERT [OVERWRITE] [ALL INTO | INTO | ALL | FIRST]",
+	"{THEN | ELSE} INTO",
+	"VALUES",
+	"SET",
+	"CLUSTER BY",
+	"[WITH] {MASKING POLICY | TAG | ROW ACCESS POLICY}",
+	"COPY GRANTS",
+	"USING TEMPLATE",
+	"MERGE INTO",
+	"WHEN MATCHED [AND]",
+	"THEN {UPDATE SET | DELETE}",
+	"WHEN NOT MATCHED THEN INSERT",
+	// MATCH_RECOGNIZE sub-clauses
+	"MEASURES",
+	"{ONE ROW | ALL ROWS} PER MATCH",
+	"AFTER MATCH SKIP",
+	"PATTERN",
+	"DEFINE",
+}
+
+var snowflakeStandardOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [VOLATILE | TRANSIENT] TABLE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [LOCAL | GLOBAL] {TEMP | TEMPORARY} TABLE [IF NOT EXISTS]",
+}
+
+var snowflakeTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [SECURE] [RECURSIVE] VIEW [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [SECURE] MATERIALIZED VIEW [IF NOT EXISTS]",
+	"UPDATE",
+	"DELETE FROM",
+	"DROP TABLE [IF EXISTS]",
+	"ALTER TABLE [IF EXISTS]",
+	"RENAME TO",
+	"SWAP WITH",
+	"[SUSPEND | RESUME] RECLUSTER",
+	"DROP CLUSTERING KEY",
+	"ADD [COLUMN]",
+	"RENAME COLUMN",
+	"{ALTER | MODIFY} [COLUMN]",
+	"DROP [COLUMN]",
+	"{ADD | ALTER | MODIFY | DROP} [CONSTRAINT]",
+	"RENAME CONSTRAINT",
+	"{ADD | DROP} SEARCH OPTIMIZATION",
+	"{SET | UNSET} TAG",
+	"{ADD | DROP} ROW ACCESS POLICY",
+	"DROP ALL ROW ACCESS POLICIES",
+	"{SET | DROP} DEFAULT",
+	"{SET | DROP} NOT NULL",
+	"SET DATA TYPE",
+	"UNSET COMMENT",
+	"{SET | UNSET} MASKING POLICY",
+	"TRUNCATE [TABLE] [IF EXISTS]",
+	"COPY INTO",
+	"CREATE [OR REPLACE] [TRANSIENT] DATABASE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [TRANSIENT] SCHEMA [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [TEMP | TEMPORARY] STAGE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [TEMP | TEMPORARY] FILE FORMAT [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] STREAM [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] TASK [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] PIPE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] SEQUENCE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] WAREHOUSE [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [SECURE] FUNCTION",
+	"CREATE [OR REPLACE] PROCEDURE",
+	"ALTER {DATABASE | SCHEMA | STAGE | STREAM | TASK | PIPE | SEQUENCE | WAREHOUSE | VIEW} [IF EXISTS]",
+	"DROP {DATABASE | SCHEMA | STAGE | FILE FORMAT | STREAM | TASK | PIPE | SEQUENCE | WAREHOUSE | VIEW | MATERIALIZED VIEW | FUNCTION | PROCEDURE} [IF EXISTS]",
+	"UNDROP {TABLE | SCHEMA | DATABASE}",
+	"DESCRIBE",
+	"DESC {TABLE | VIEW | STAGE | FILE FORMAT | STREAM | TASK | PIPE | FUNCTION | PROCEDURE}",
+	"SHOW",
+	"USE [ROLE | WAREHOUSE | DATABASE | SCHEMA]",
+	"GRANT",
+	"REVOKE",
+	"LIST",
+	"LS",
+	"PUT",
+	"GET",
+	"REMOVE",
+	"RM",
+	"BEGIN [TRANSACTION]",
+	"COMMIT",
+	"ROLLBACK",
+	"EXECUTE {IMMEDIATE | TASK}",
+	"CALL",
+	"UNSET",
+}
+
+var snowflakeReservedSetOperationsPhrases = []string{
+	"UNION [ALL]",
+	"MINUS",
+	"EXCEPT",
+	"INTERSECT",
+}
+
+var snowflakeReservedJoinsPhrases = []string{
+	"[INNER] JOIN",
+	"[NATURAL] {LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{CROSS | NATURAL} JOIN",
+}
+
+var snowflakeReservedKeywordPhrasesPhrases = []string{
+	"{ROWS | RANGE} BETWEEN",
+	"ON {UPDATE | DELETE} [SET NULL | SET DEFAULT]",
+}
+
+var snowflakeReservedDataTypePhrasesPhrases = []string{
+	"DOUBLE PRECISION",
+	"TIMESTAMP {WITH | WITHOUT} TIME ZONE",
+	"TIMESTAMP WITH LOCAL TIME ZONE",
+}
+
+var SnowflakeDialect = DialectOptions{
+	Name: "snowflake",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:          ExpandPhrases(snowflakeReservedSelectPhrases),
+		ReservedClauses:         append(append([]string{}, ExpandPhrases(snowflakeReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(snowflakeStandardOnelineClausesPhrases)...), ExpandPhrases(snowflakeTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:   ExpandPhrases(snowflakeReservedSetOperationsPhrases),
+		ReservedJoins:           ExpandPhrases(snowflakeReservedJoinsPhrases),
+		ReservedKeywordPhrases:  ExpandPhrases(snowflakeReservedKeywordPhrasesPhrases),
+		ReservedDataTypePhrases: ExpandPhrases(snowflakeReservedDataTypePhrasesPhrases),
+		ReservedKeywords:        snowflake.Keywords,
+		ReservedDataTypes:       snowflake.DataTypes,
+		ReservedFunctionNames:   snowflake.Functions,
+		StringTypes:             []QuoteType{PlainQuoteType("$$"), PlainQuoteType("''-qq-bs")},
+		IdentTypes:              []QuoteType{PlainQuoteType("\"\"-qq")},
+		VariableTypes: []VariableType{
+			// column positions in staged files: $1, $2, ...
+			RegexPattern{Regex: `[$][1-9]\d*`},
+			// session variables: $name
+			RegexPattern{Regex: `[$][_a-zA-Z][_a-zA-Z0-9$]*`},
+			// stage references: @stage, @~, @%table, @db.schema.stage/path/
+			RegexPattern{Regex: `@(?:~|%[\w$]+|[\w$]+(?:\.[\w$]+)*)(?:/[\w$.\-=]*)*`},
+		},
+		ExtraParens:             []string{"[]"},
+		IdentChars:              &IdentChars{Rest: "$"},
+		LineCommentTypes:        []string{"--", "//"},
+		Operators:               []string{"%", "::", "||", "=>", ":=", "->"},
+		PropertyAccessOperators: []string{":"},
+		PostProcess:             snowflakePostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		AlwaysDenseOperators:  []string{"::"},
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(snowflakeStandardOnelineClausesPhrases)...), ExpandPhrases(snowflakeTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(snowflakeTabularOnelineClausesPhrases),
+	},
+}
+
+// snowflakePostProcess keeps PATTERN as a clause only inside MATCH_RECOGNIZE,
+// where it is followed by a parenthesized row pattern. Elsewhere (e.g. the
+// PATTERN = '...' option of COPY INTO) it is an ordinary keyword.
+func snowflakePostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type == TokenReservedClause && token.Text == "PATTERN" && !isOpenParen(nextNonCommentToken(tokens, i)) {
+			tokens[i].Type = TokenReservedKeyword
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,163 @@
+package sqlformatter
+
+import "testing"
+
+func TestSnowflakeFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageSnowflake, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageSnowflake, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{DoubleSlashComments: true})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{OrReplace: true, IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsConstraints(t, format, []string{"CASCADE", "RESTRICT", "NO ACTION", "SET NULL", "SET DEFAULT"})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true, WithoutTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq-bs"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq"})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{SupportsUsing: true, Without: []string{"NATURAL INNER JOIN"}})
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "MINUS", "EXCEPT", "INTERSECT"})
+	supportsOperators(t, format, []string{"%", "||", "=>", ":=", "->"}, operatorConfig{Any: true})
+	supportsArrayLiterals(t, format, arrayLiteralConfig{WithoutArrayPrefix: true})
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true, FetchFirst: true, FetchNext: true})
+	supportsDataTypeCase(t, format)
+
+	// Snowflake only has untagged $$ strings; $name is a session variable.
+	t.Run("supports dollar-quoted strings", func(t *testing.T) {
+		assertEqual(t, format(`$$foo JOIN bar$$`), `$$foo JOIN bar$$`)
+		assertEqual(t, format("$$foo \n bar$$"), "$$foo \n bar$$")
+		expected := dedent(`
+			SELECT
+			  $$where$$,
+			  $abc
+			FROM
+			  $$update$$
+		`)
+		assertEqual(t, format(`SELECT $$where$$, $abc FROM $$update$$`), expected)
+	})
+
+	t.Run("supports stage references", func(t *testing.T) {
+		result := format("SELECT t.$1, $2 FROM @my_db.my_schema.my_stage/data/2024/ (FILE_FORMAT => 'my_csv') t; LIST @~; REMOVE @%orders;")
+		expected := dedent(`
+			SELECT
+			  t.$1,
+			  $2
+			FROM
+			  @my_db.my_schema.my_stage/data/2024/ (FILE_FORMAT => 'my_csv') t;
+
+			LIST @~;
+
+			REMOVE @%orders;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports COPY INTO", func(t *testing.T) {
+		result := format("COPY INTO orders FROM @my_stage/orders/ FILE_FORMAT = (TYPE = CSV) PATTERN = '.*[.]csv' ON_ERROR = CONTINUE;")
+		expected := dedent(`
+			COPY INTO orders
+			FROM
+			  @my_stage/orders/ FILE_FORMAT = (TYPE = CSV) PATTERN = '.*[.]csv' ON_ERROR = CONTINUE;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports : path access on VARIANT columns", func(t *testing.T) {
+		result := format("SELECT src:customer[0].name::VARCHAR AS name, src:\"zip code\" FROM raw, LATERAL FLATTEN(input => src:items) f")
+		expected := dedent(`
+			SELECT
+			  src:customer[0].name::VARCHAR AS name,
+			  src:"zip code"
+			FROM
+			  raw,
+			  LATERAL FLATTEN(input => src:items) f
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats :: type casts without spaces", func(t *testing.T) {
+		assertEqual(t, format("SELECT x :: VARCHAR, '1' :: INT"), dedent(`
+			SELECT
+			  x::VARCHAR,
+			  '1'::INT
+		`))
+	})
+
+	t.Run("supports $variables", func(t *testing.T) {
+		result := format("SET min_id = 10; SELECT * FROM t WHERE id > $min_id;")
+		expected := dedent(`
+			SET
+			  min_id = 10;
+
+			SELECT
+			  *
+			FROM
+			  t
+			WHERE
+			  id > $min_id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports QUALIFY clause", func(t *testing.T) {
+		result := format("SELECT id FROM t QUALIFY ROW_NUMBER() OVER (PARTITION BY id ORDER BY ts DESC) = 1")
+		expected := dedent(`
+			SELECT
+			  id
+			FROM
+			  t
+			QUALIFY
+			  ROW_NUMBER() OVER (
+			    PARTITION BY
+			      id
+			    ORDER BY
+			      ts DESC
+			  ) = 1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports CLONE", func(t *testing.T) {
+		result := format("create or replace table t2 clone t1;", FormatOptions{KeywordCase: KeywordCaseUpper})
+		assertEqual(t, result, "CREATE OR REPLACE TABLE t2 CLONE t1;")
+	})
+
+	t.Run("supports MATCH_RECOGNIZE", func(t *testing.T) {
+		result := format(`SELECT * FROM ticker MATCH_RECOGNIZE (
+			PARTITION BY company ORDER BY price_date
+			MEASURES MATCH_NUMBER() AS mn
+			ONE ROW PER MATCH
+			PATTERN (down+ up+)
+			DEFINE down AS price < PREV(price), up AS price > PREV(price))`)
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  ticker MATCH_RECOGNIZE (
+			    PARTITION BY
+			      company
+			    ORDER BY
+			      price_date
+			    MEASURES
+			      MATCH_NUMBER() AS mn
+			    ONE ROW PER MATCH
+			    PATTERN
+			      (down + up +)
+			    DEFINE
+			      down AS price < PREV(price),
+			      up AS price > PREV(price)
+			  )
+		`)
+		assertEqual(t, result, expected)
+	})
+}
@@ -0,0 +1,162 @@
+package sqlformatter
+
+import spark "sql-formatter-go/languages/spark"
+
+var sparkReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT]",
+}
+
+var sparkReservedClausesPhrases = []string{
+	"WITH",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"WINDOW",
+	"PARTITION BY",
+	"PARTITIONED BY",
+	"CLUSTERED BY",
+	"ORDER BY",
+	"SORT BY",
+	"CLUSTER BY",
+	"DISTRIBUTE BY",
+	"LIMIT",
+	"INSERT [INTO | OVERWRITE] [TABLE]",
+	"VALUES",
+	"INSERT OVERWRITE [LOCAL] DIRECTORY",
+	"LOAD DATA [LOCAL] INPATH",
+	"[OVERWRITE] INTO TABLE",
+	"MERGE INTO",
+	"WHEN [NOT] MATCHED [BY SOURCE | BY TARGET] [THEN]",
+	"UPDATE SET",
+	"SET",
+}
+
+var sparkStandardOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [EXTERNAL] TABLE [IF NOT EXISTS]",
+}
+
+var sparkTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [GLOBAL TEMPORARY | TEMPORARY] VIEW [IF NOT EXISTS]",
+	"USING",
+	"LOCATION",
+	"TBLPROPERTIES",
+	"OPTIONS",
+	"STORED AS",
+	"ROW FORMAT",
+	"UPDATE",
+	"DELETE FROM",
+	"DROP TABLE [IF EXISTS]",
+	"ALTER TABLE",
+	"ADD COLUMNS",
+	"DROP {COLUMN | COLUMNS}",
+	"RENAME TO",
+	"RENAME COLUMN",
+	"ALTER COLUMN",
+	"TRUNCATE TABLE",
+	"LATERAL VIEW [OUTER]",
+	"ALTER DATABASE",
+	"ALTER VIEW",
+	"CREATE {DATABASE | SCHEMA} [IF NOT EXISTS]",
+	"CREATE [OR REPLACE] [TEMPORARY] FUNCTION [IF NOT EXISTS]",
+	"DROP {DATABASE | SCHEMA} [IF EXISTS]",
+	"DROP [TEMPORARY] FUNCTION [IF EXISTS]",
+	"DROP VIEW [IF EXISTS]",
+	"REPAIR TABLE",
+	"USE [DATABASE]",
+	"TABLESAMPLE",
+	"PIVOT",
+	"TRANSFORM",
+	"EXPLAIN",
+	"ADD FILE",
+	"ADD JAR",
+	"ANALYZE TABLE",
+	"CACHE [LAZY] TABLE",
+	"CLEAR CACHE",
+	"DESCRIBE [EXTENDED | FORMATTED] [TABLE | DATABASE | FUNCTION | QUERY]",
+	"LIST FILE",
+	"LIST JAR",
+	"REFRESH [TABLE | FUNCTION]",
+	"RESET",
+	"SHOW {DATABASES | SCHEMAS | TABLES | VIEWS | FUNCTIONS | PARTITIONS | COLUMNS | TBLPROPERTIES | CREATE TABLE}",
+	"UNCACHE TABLE [IF EXISTS]",
+	"OPTIMIZE",
+	"ZORDER BY",
+	"VACUUM",
+}
+
+var sparkReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT]",
+	"EXCEPT [ALL | DISTINCT]",
+	"INTERSECT [ALL | DISTINCT]",
+}
+
+var sparkReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"NATURAL [INNER] JOIN",
+	"NATURAL {LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"[LEFT] {ANTI | SEMI} JOIN",
+	"NATURAL [LEFT] {ANTI | SEMI} JOIN",
+}
+
+var sparkReservedKeywordPhrasesPhrases = []string{
+	"ON DELETE",
+	"ON UPDATE",
+	"CURRENT ROW",
+	"{ROWS | RANGE} BETWEEN",
+}
+
+var SparkDialect = DialectOptions{
+	Name: "spark",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(sparkReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(sparkReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(sparkStandardOnelineClausesPhrases)...), ExpandPhrases(sparkTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(sparkReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(sparkReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(sparkReservedKeywordPhrasesPhrases),
+		SupportsXor:            true,
+		ReservedKeywords:       spark.Keywords,
+		ReservedDataTypes:      spark.DataTypes,
+		ReservedFunctionNames:  spark.Functions,
+		ExtraParens:            []string{"[]"},
+		StringTypes: []QuoteType{
+			PlainQuoteType("''-bs"),
+			PlainQuoteType("\"\"-bs"),
+			PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: []string{"R", "X"}, RequirePrefix: true},
+			PrefixedQuoteType{Quote: PlainQuoteType("\"\"-raw"), Prefixes: []string{"R", "X"}, RequirePrefix: true},
+		},
+		IdentTypes: []QuoteType{PlainQuoteType("``")},
+		// Hive/Databricks substitution variables: ${var}, ${hivevar:var}
+		VariableTypes: []VariableType{RegexPattern{Regex: `\$\{[^{}]+\}`}},
+		Operators:     []string{"%", "~", "^", "|", "&", "<=>", "==", "!", "||", "->"},
+		PostProcess:   sparkPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(sparkStandardOnelineClausesPhrases)...), ExpandPhrases(sparkTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(sparkTabularOnelineClausesPhrases),
+	},
+}
+
+// sparkPostProcess keeps the clause keywords that double as functions or as
+// join conditions where they are used that way: window(...), transform(...)
+// and JOIN ... USING (col).
+func sparkPostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenReservedClause {
+			continue
+		}
+		switch token.Text {
+		case "WINDOW", "TRANSFORM":
+			if isOpenParen(nextNonCommentToken(tokens, i)) && prevNonCommentToken(tokens, i).Type != TokenReservedClause {
+				tokens[i].Type = TokenReservedFunctionName
+			}
+		case "USING":
+			if isOpenParen(nextNonCommentToken(tokens, i)) {
+				tokens[i].Type = TokenReservedKeyword
+			}
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,128 @@
+package sqlformatter
+
+import "testing"
+
+func TestSparkFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageSpark, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageSpark, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsAlterTable(t, format, alterTableConfig{DropColumn: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-bs", "\"\"-bs", "X''", "X\"\"", "R''", "R\"\""})
+	supportsIdentifiers(t, format, formatErr, []string{"``"})
+	supportsArrayAndMapAccessors(t, format)
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{SupportsUsing: true, Additionally: []string{"LEFT SEMI JOIN", "LEFT ANTI JOIN", "SEMI JOIN", "ANTI JOIN"}})
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "UNION DISTINCT", "EXCEPT", "EXCEPT ALL", "INTERSECT", "INTERSECT ALL"})
+	supportsOperators(t, format, []string{"%", "~", "^", "|", "&", "<=>", "==", "!", "||"}, operatorConfig{Any: true})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("formats CREATE TABLE with USING and PARTITIONED BY", func(t *testing.T) {
+		result := format("CREATE TABLE IF NOT EXISTS events (id BIGINT, dt STRING) USING delta PARTITIONED BY (dt) LOCATION '/mnt/events' TBLPROPERTIES ('delta.appendOnly' = 'true');")
+		expected := dedent(`
+			CREATE TABLE IF NOT EXISTS events (id BIGINT, dt STRING)
+			USING delta
+			PARTITIONED BY
+			  (dt)
+			LOCATION '/mnt/events'
+			TBLPROPERTIES ('delta.appendOnly' = 'true');
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats LATERAL VIEW", func(t *testing.T) {
+		result := format("SELECT id, tag FROM events LATERAL VIEW OUTER explode(tags) t AS tag WHERE id > 1")
+		expected := dedent(`
+			SELECT
+			  id,
+			  tag
+			FROM
+			  events
+			LATERAL VIEW OUTER explode(tags) t AS tag
+			WHERE
+			  id > 1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats DISTRIBUTE BY, SORT BY and CLUSTER BY", func(t *testing.T) {
+		result := format("SELECT * FROM a DISTRIBUTE BY id SORT BY ts; SELECT * FROM b CLUSTER BY id;")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  a
+			DISTRIBUTE BY
+			  id
+			SORT BY
+			  ts;
+
+			SELECT
+			  *
+			FROM
+			  b
+			CLUSTER BY
+			  id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports ${var} substitution variables", func(t *testing.T) {
+		result := format("SELECT * FROM ${db}.events WHERE dt = '${hivevar:run_date}' AND n > ${min_n}")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  ${db}.events
+			WHERE
+			  dt = '${hivevar:run_date}'
+			  AND n > ${min_n}
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats MERGE INTO", func(t *testing.T) {
+		result := format("MERGE INTO target t USING source s ON t.id = s.id WHEN MATCHED THEN UPDATE SET t.v = s.v WHEN NOT MATCHED THEN INSERT (id, v) VALUES (s.id, s.v);")
+		expected := dedent(`
+			MERGE INTO
+			  target t
+			USING source s ON t.id = s.id
+			WHEN MATCHED THEN
+			UPDATE SET
+			  t.v = s.v
+			WHEN NOT MATCHED THEN
+			INSERT
+			  (id, v)
+			VALUES
+			  (s.id, s.v);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("treats transform() and window() as functions", func(t *testing.T) {
+		result := format("SELECT transform(arr, x -> x + 1), window(ts, '5 minutes') FROM t")
+		expected := dedent(`
+			SELECT
+			  transform(arr, x -> x + 1),
+			  window(ts, '5 minutes')
+			FROM
+			  t
+		`)
+		assertEqual(t, result, expected)
+	})
+}
@@ -1,22 +1,49 @@
 package sqlformatter
 
 import (
-	"fmt"
+	"context"
+	"sync"
 )
 
 type SqlLanguage string
 
 const (
-	LanguageSQL        SqlLanguage = "sql"
-	LanguagePostgresql SqlLanguage = "postgresql"
+	LanguageSQL         SqlLanguage = "sql"
+	LanguagePostgresql  SqlLanguage = "postgresql"
+	LanguageMysql       SqlLanguage = "mysql"
+	LanguageMariadb     SqlLanguage = "mariadb"
+	LanguageSqlite      SqlLanguage = "sqlite"
+	LanguageBigquery    SqlLanguage = "bigquery"
+	LanguageSnowflake   SqlLanguage = "snowflake"
+	LanguageTransactsql SqlLanguage = "transactsql"
+	LanguageTsql        SqlLanguage = "tsql"
+	LanguagePlsql       SqlLanguage = "plsql"
+	LanguageDuckdb      SqlLanguage = "duckdb"
+	LanguageClickhouse  SqlLanguage = "clickhouse"
+	LanguageSpark       SqlLanguage = "spark"
+	LanguageRedshift    SqlLanguage = "redshift"
+	LanguageTrino       SqlLanguage = "trino"
 )
 
 var dialectNameMap = map[SqlLanguage]DialectOptions{
-	LanguageSQL:        PostgresqlDialect,
-	LanguagePostgresql: PostgresqlDialect,
+	LanguageSQL:         PostgresqlDialect,
+	LanguagePostgresql:  PostgresqlDialect,
+	LanguageMysql:       MysqlDialect,
+	LanguageMariadb:     MariadbDialect,
+	LanguageSqlite:      SqliteDialect,
+	LanguageBigquery:    BigqueryDialect,
+	LanguageSnowflake:   SnowflakeDialect,
+	LanguageTransactsql: TransactsqlDialect,
+	LanguageTsql:        TransactsqlDialect,
+	LanguagePlsql:       PlsqlDialect,
+	LanguageDuckdb:      DuckdbDialect,
+	LanguageClickhouse:  ClickhouseDialect,
+	LanguageSpark:       SparkDialect,
+	LanguageRedshift:    RedshiftDialect,
+	LanguageTrino:       TrinoDialect,
 }
 
-var supportedDialects = []string{"postgresql", "sql"}
+var supportedDialects = []string{"bigquery", "clickhouse", "duckdb", "mariadb", "mysql", "plsql", "postgresql", "redshift", "snowflake", "spark", "sql", "sqlite", "transactsql", "trino", "tsql"}
 
 var defaultOptions = FormatOptions{
 	TabWidth:               2,
@@ -33,37 +60,54 @@ var defaultOptions = FormatOptions{
 	NewlineBeforeSemicolon: false,
 }
 
+// Format formats query with the language and options in cfg. To format many
+// queries with the same configuration, create a Formatter once with New.
 func Format(query string, cfg FormatOptionsWithLanguage) (string, error) {
-	if cfg.Language != "" {
-		if _, ok := dialectNameMap[cfg.Language]; !ok {
-			return "", ConfigError{Message: fmt.Sprintf("Unsupported SQL dialect: %s", cfg.Language)}
-		}
-	} else {
-		cfg.Language = LanguagePostgresql
-	}
-	options := cfg.FormatOptions
-	options = mergeOptions(defaultOptions, options)
-	validated, err := validateConfig(options)
+	formatter, err := New(cfg)
 	if err != nil {
 		return "", err
 	}
-	dialect := CreateDialect(dialectNameMap[cfg.Language])
-	formatter := NewFormatter(dialect, validated)
 	return formatter.Format(query)
 }
 
+// FormatContext is like Format but stops with ctx.Err() once ctx is done, and
+// enforces cfg.Limits. Use it to format untrusted input.
+func FormatContext(ctx context.Context, query string, cfg FormatOptionsWithLanguage) (string, error) {
+	formatter, err := New(cfg)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatContext(ctx, query)
+}
+
+// FormatDialect formats query with a dialect described by DialectOptions. The
+// compiled dialect is looked up in a bounded cache keyed by the options'
+// content; use NewDialect and FormatWithDialect to hold on to it explicitly.
 func FormatDialect(query string, cfg FormatOptionsWithDialect) (string, error) {
-	options := cfg.FormatOptions
-	options = mergeOptions(defaultOptions, options)
-	validated, err := validateConfig(options)
+	return FormatWithDialect(query, CreateDialect(cfg.Dialect), cfg.FormatOptions)
+}
+
+// FormatWithDialect formats query with a dialect created by NewDialect.
+func FormatWithDialect(query string, dialect *Dialect, cfg FormatOptions) (string, error) {
+	formatter, err := NewWithDialect(dialect, cfg)
 	if err != nil {
 		return "", err
 	}
-	dialect := CreateDialect(cfg.Dialect)
-	formatter := NewFormatter(dialect, validated)
 	return formatter.Format(query)
 }
 
+// languageDialects holds the compiled built-in dialects. They live outside
+// the bounded dialect cache so custom dialects can't evict them.
+var languageDialects sync.Map
+
+func languageDialect(language SqlLanguage) *Dialect {
+	if cached, ok := languageDialects.Load(language); ok {
+		return cached.(*Dialect)
+	}
+	dialect, _ := languageDialects.LoadOrStore(language, NewDialect(dialectNameMap[language]))
+	return dialect.(*Dialect)
+}
+
 func mergeOptions(base FormatOptions, override FormatOptions) FormatOptions {
 	if override.TabWidth != 0 {
 		base.TabWidth = override.TabWidth
@@ -107,5 +151,8 @@ func mergeOptions(base FormatOptions, override FormatOptions) FormatOptions {
 	if override.ParamTypes != nil {
 		base.ParamTypes = override.ParamTypes
 	}
+	if override.Limits != (Limits{}) {
+		base.Limits = override.Limits
+	}
 	return base
 }
@@ -0,0 +1,116 @@
+package sqlformatter
+
+import sqlite "sql-formatter-go/languages/sqlite"
+
+var sqliteReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT]",
+}
+
+var sqliteReservedClausesPhrases = []string{
+	"WITH [RECURSIVE]",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"WINDOW",
+	"PARTITION BY",
+	"ORDER BY",
+	"LIMIT",
+	"OFFSET",
+	"INSERT [OR ABORT | OR FAIL | OR IGNORE | OR REPLACE | OR ROLLBACK] INTO",
+	"REPLACE INTO",
+	"VALUES",
+	"DEFAULT VALUES",
+	"SET",
+	"RETURNING",
+}
+
+var sqliteStandardOnelineClausesPhrases = []string{
+	"CREATE [TEMPORARY | TEMP] TABLE [IF NOT EXISTS]",
+}
+
+var sqliteTabularOnelineClausesPhrases = []string{
+	"CREATE [TEMPORARY | TEMP] VIEW [IF NOT EXISTS]",
+	"UPDATE [OR ABORT | OR FAIL | OR IGNORE | OR REPLACE | OR ROLLBACK]",
+	"ON CONFLICT",
+	"DELETE FROM",
+	"DROP TABLE [IF EXISTS]",
+	"ALTER TABLE",
+	"ADD [COLUMN]",
+	"DROP [COLUMN]",
+	"RENAME [COLUMN]",
+	"RENAME TO",
+	"SET SCHEMA",
+	"ANALYZE",
+	"ATTACH [DATABASE]",
+	"BEGIN [DEFERRED | IMMEDIATE | EXCLUSIVE] [TRANSACTION]",
+	"COMMIT [TRANSACTION]",
+	"CREATE [UNIQUE] INDEX [IF NOT EXISTS]",
+	"CREATE [TEMPORARY | TEMP] TRIGGER [IF NOT EXISTS]",
+	"CREATE VIRTUAL TABLE [IF NOT EXISTS]",
+	"DETACH [DATABASE]",
+	"DROP INDEX [IF EXISTS]",
+	"DROP TRIGGER [IF EXISTS]",
+	"DROP VIEW [IF EXISTS]",
+	"END [TRANSACTION]",
+	"EXPLAIN [QUERY PLAN]",
+	"PRAGMA",
+	"REINDEX",
+	"RELEASE [SAVEPOINT]",
+	"ROLLBACK [TRANSACTION]",
+	"SAVEPOINT",
+	"VACUUM",
+}
+
+var sqliteReservedSetOperationsPhrases = []string{
+	"UNION [ALL]",
+	"EXCEPT",
+	"INTERSECT",
+}
+
+var sqliteReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"NATURAL [INNER] JOIN",
+	"NATURAL {LEFT | RIGHT | FULL} [OUTER] JOIN",
+}
+
+var sqliteReservedKeywordPhrasesPhrases = []string{
+	"ON {UPDATE | DELETE} [SET NULL | SET DEFAULT]",
+	"{ROWS | RANGE | GROUPS} BETWEEN",
+	"DO UPDATE",
+	"DO NOTHING",
+	"WITHOUT ROWID",
+	"NULLS {FIRST | LAST}",
+	"IS [NOT] DISTINCT FROM",
+}
+
+var SqliteDialect = DialectOptions{
+	Name: "sqlite",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(sqliteReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(sqliteReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(sqliteStandardOnelineClausesPhrases)...), ExpandPhrases(sqliteTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(sqliteReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(sqliteReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(sqliteReservedKeywordPhrasesPhrases),
+		ReservedKeywords:       sqlite.Keywords,
+		ReservedDataTypes:      sqlite.DataTypes,
+		ReservedFunctionNames:  sqlite.Functions,
+		StringTypes: []QuoteType{
+			PlainQuoteType("''-qq"),
+			PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: []string{"X"}, RequirePrefix: true},
+		},
+		IdentTypes: []QuoteType{
+			PlainQuoteType("\"\"-qq"),
+			PlainQuoteType("``"),
+			PlainQuoteType("[]"),
+		},
+		ParamTypes: &ParamTypes{Positional: true, Numbered: []string{"?"}, Named: []string{":", "@", "$"}},
+		Operators:  []string{"%", "~", "&", "|", "<<", ">>", "==", "->", "->>", "||"},
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(sqliteStandardOnelineClausesPhrases)...), ExpandPhrases(sqliteTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(sqliteTabularOnelineClausesPhrases),
+	},
+}
@@ -0,0 +1,91 @@
+package sqlformatter
+
+import "testing"
+
+func TestSqliteFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageSqlite, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageSqlite, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{})
+	supportsCreateView(t, format, createViewConfig{IfNotExists: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsConstraints(t, format, []string{"SET NULL", "SET DEFAULT", "CASCADE", "RESTRICT", "NO ACTION"})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsOnConflict(t, format)
+	supportsUpdate(t, format, updateConfig{})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "X''"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq", "``", "[]"})
+	supportsNumbers(t, format, numbersConfig{})
+	supportsBetween(t, format)
+	supportsJoin(t, format)
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "EXCEPT", "INTERSECT"})
+	supportsOperators(t, format, []string{"%", "~", "&", "|", "<<", ">>", "==", "->", "->>", "||"}, operatorConfig{})
+	supportsParams(t, format, paramConfig{Positional: true, Numbered: []string{"?"}, Named: []string{":", "$", "@"}})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true})
+	supportsReturning(t, format)
+	supportsDataTypeCase(t, format)
+
+	t.Run("supports REPLACE INTO syntax", func(t *testing.T) {
+		result := format("REPLACE INTO tbl VALUES (1,'Leopard'),(2,'Bear');")
+		expected := dedent(`
+			REPLACE INTO
+			  tbl
+			VALUES
+			  (1, 'Leopard'),
+			  (2, 'Bear');
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports INSERT OR REPLACE syntax", func(t *testing.T) {
+		result := format("INSERT OR REPLACE INTO tbl VALUES (1,'Leopard'),(2,'Bear');")
+		expected := dedent(`
+			INSERT OR REPLACE INTO
+			  tbl
+			VALUES
+			  (1, 'Leopard'),
+			  (2, 'Bear');
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports UPSERT with DO UPDATE", func(t *testing.T) {
+		result := format("INSERT INTO tbl (id, cnt) VALUES (1, 1) ON CONFLICT (id) DO UPDATE SET cnt = cnt + 1;")
+		expected := dedent(`
+			INSERT INTO
+			  tbl (id, cnt)
+			VALUES
+			  (1, 1)
+			ON CONFLICT (id) DO UPDATE
+			SET
+			  cnt = cnt + 1;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports PRAGMA statements", func(t *testing.T) {
+		result := format("pragma foreign_keys = 1;\nPRAGMA table_info(tbl);", FormatOptions{KeywordCase: KeywordCaseUpper})
+		expected := dedent(`
+			PRAGMA foreign_keys = 1;
+
+			PRAGMA table_info (tbl);
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports WITHOUT ROWID tables", func(t *testing.T) {
+		result := format("create table foo (id integer primary key) without rowid;", FormatOptions{KeywordCase: KeywordCaseUpper})
+		expected := "CREATE TABLE foo (id integer PRIMARY KEY) WITHOUT ROWID;"
+		assertEqual(t, result, expected)
+	})
+}
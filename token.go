@@ -44,7 +44,12 @@ const (
 	TokenNumberedParameter             TokenType = "NUMBERED_PARAMETER"
 	TokenPositionalParameter           TokenType = "POSITIONAL_PARAMETER"
 	TokenCustomParameter               TokenType = "CUSTOM_PARAMETER"
+	TokenTypedParameter                TokenType = "TYPED_PARAMETER"
 	TokenDelimiter                     TokenType = "DELIMITER"
+	TokenBatchSeparator                TokenType = "BATCH_SEPARATOR"
+	TokenBlockStart                    TokenType = "BLOCK_START"
+	TokenBlockSection                  TokenType = "BLOCK_SECTION"
+	TokenBlockEnd                      TokenType = "BLOCK_END"
 	TokenEOF                           TokenType = "EOF"
 )
 
@@ -55,6 +60,12 @@ type Token struct {
 	Key                 string
 	Start               int
 	PrecedingWhitespace string
+	// End is the offset just past the token in the source. It differs from
+	// Start+len(Raw) for tokens merged across whitespace, like ORDER BY.
+	End int
+	// unclosedBlock marks the keyword that would open a block had the block
+	// been closed before the end of the input.
+	unclosedBlock bool
 }
 
 func CreateEofToken(index int) Token {
@@ -63,6 +74,7 @@ func CreateEofToken(index int) Token {
 		Raw:   "«EOF»",
 		Text:  "«EOF»",
 		Start: index,
+		End:   index,
 	}
 }
 
@@ -89,7 +101,10 @@ func IsReserved(t TokenType) bool {
 		TokenBetween,
 		TokenAnd,
 		TokenOr,
-		TokenXor:
+		TokenXor,
+		TokenBlockStart,
+		TokenBlockSection,
+		TokenBlockEnd:
 		return true
 	default:
 		return false
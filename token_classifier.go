@@ -266,6 +266,7 @@ func mergeTokens(tokens []Token, start int, entry phraseEntry) Token {
 		Raw:                 b.String(),
 		Text:                entry.text,
 		Start:               tokens[start].Start,
+		End:                 tokens[start+len(entry.words)-1].End,
 		PrecedingWhitespace: tokens[start].PrecedingWhitespace,
 	}
 }
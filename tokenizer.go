@@ -1,6 +1,7 @@
 package sqlformatter
 
 import (
+	"context"
 	"regexp"
 	"strings"
 )
@@ -21,11 +22,19 @@ func NewTokenizer(cfg TokenizerOptions, dialectName string) *Tokenizer {
 }
 
 func (t *Tokenizer) Tokenize(input string, paramTypesOverrides *ParamTypes) ([]Token, error) {
+	return t.tokenize(context.Background(), input, paramTypesOverrides, 0)
+}
+
+// tokenize is Tokenize with cancellation and a limit on the number of
+// tokens; a zero maxTokens means no limit.
+func (t *Tokenizer) tokenize(ctx context.Context, input string, paramTypesOverrides *ParamTypes, maxTokens int) ([]Token, error) {
 	rules := make([]TokenRule, 0, len(t.rulesBefore)+len(t.rulesAfter)+5)
 	rules = append(rules, t.rulesBefore...)
 	rules = append(rules, t.buildParamRules(t.cfg, paramTypesOverrides)...)
 	rules = append(rules, t.rulesAfter...)
 	engine := NewTokenizerEngine(rules, t.dialectName)
+	engine.ctx = ctx
+	engine.maxTokens = maxTokens
 	tokens, err := engine.Tokenize(input)
 	if err != nil {
 		return nil, err
@@ -135,6 +144,9 @@ func (t *Tokenizer) buildParamRules(cfg TokenizerOptions, overrides *ParamTypes)
 		re := regexp.MustCompile(`^\?`)
 		rules = append(rules, TokenRule{Type: TokenPositionalParameter, Regex: &RegexMatcher{re: newRegexpWrapper(re)}})
 	}
+	if paramTypes.Typed {
+		rules = append(rules, TokenRule{Type: TokenTypedParameter, Regex: TypedParameterMatcher{}, Key: typedParameterKey})
+	}
 	for _, custom := range paramTypes.Custom {
 		pattern := custom.Regex
 		if pattern == "" {
@@ -173,6 +185,9 @@ func mergeParamTypes(defaults *ParamTypes, overrides *ParamTypes) *ParamTypes {
 	if overrides.Positional {
 		out.Positional = true
 	}
+	if overrides.Typed {
+		out.Typed = true
+	}
 	if overrides.Custom != nil {
 		out.Custom = overrides.Custom
 	}
@@ -1,9 +1,9 @@
 package sqlformatter
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"regexp"
+	"strings"
 	"unicode"
 )
 
@@ -44,6 +44,8 @@ type TokenizerEngine struct {
 	dialectName string
 	input       string
 	index       int
+	ctx         context.Context
+	maxTokens   int
 }
 
 func NewTokenizerEngine(rules []TokenRule, dialectName string) *TokenizerEngine {
@@ -66,6 +68,14 @@ func (t *TokenizerEngine) Tokenize(input string) ([]Token, error) {
 		}
 		token.PrecedingWhitespace = precedingWhitespace
 		tokens = append(tokens, token)
+		if t.maxTokens > 0 && len(tokens) > t.maxTokens {
+			return nil, LimitError{Limit: LimitTokens, Max: t.maxTokens}
+		}
+		if t.ctx != nil && len(tokens)%1024 == 0 {
+			if err := t.ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return tokens, nil
 }
@@ -86,15 +96,11 @@ func (t *TokenizerEngine) createParseError() error {
 	if len(text) > 10 {
 		text = text[:10]
 	}
-	line, col := lineColFromIndex(t.input, t.index)
-	return errors.New(fmt.Sprintf("Parse error: Unexpected \"%s\" at line %d column %d.\n%s", text, line, col, t.dialectInfo()))
-}
-
-func (t *TokenizerEngine) dialectInfo() string {
-	if t.dialectName == "sql" {
-		return "This likely happens because you're using the default \"sql\" dialect.\nIf possible, please select a more specific dialect (like sqlite, postgresql, etc)."
+	// keep the message on one line
+	if i := strings.IndexAny(text, "\r\n"); i >= 0 {
+		text = text[:i]
 	}
-	return fmt.Sprintf("SQL dialect used: \"%s\".", t.dialectName)
+	return newParseError(t.input, t.index, text, nil, t.dialectName)
 }
 
 func (t *TokenizerEngine) getWhitespace() string {
@@ -129,7 +135,7 @@ func (t *TokenizerEngine) getNextToken() (Token, bool) {
 			if rule.Text != nil {
 				text = rule.Text(raw)
 			}
-			token := Token{Type: rule.Type, Raw: raw, Text: text, Start: t.index}
+			token := Token{Type: rule.Type, Raw: raw, Text: text, Start: t.index, End: t.index + len(raw)}
 			if rule.Key != nil {
 				token.Key = rule.Key(raw)
 			}
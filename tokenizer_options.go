@@ -28,6 +28,7 @@ type ParamTypes struct {
 	Numbered   []string
 	Named      []string
 	Quoted     []string
+	Typed      bool // {name:Type} parameters, as used by ClickHouse
 	Custom     []CustomParameter
 }
 
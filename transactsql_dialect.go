@@ -0,0 +1,200 @@
+package sqlformatter
+
+import (
+	"strings"
+
+	transactsql "sql-formatter-go/languages/transactsql"
+)
+
+var transactsqlReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT]",
+}
+
+var transactsqlReservedClausesPhrases = []string{
+	"WITH",
+	"INTO",
+	"FROM",
+	"WHERE",
+	"GROUP BY",
+	"HAVING",
+	"WINDOW",
+	"PARTITION BY",
+	"ORDER BY",
+	"OFFSET",
+	"FETCH {FIRST | NEXT}",
+	"FOR {BROWSE | XML | JSON}",
+	"OPTION",
+	"INSERT [INTO]",
+	"VALUES",
+	"SET",
+	"OUTPUT",
+	"MERGE [INTO]",
+	"WHEN [NOT] MATCHED [BY TARGET | BY SOURCE] [THEN]",
+	"UPDATE SET",
+}
+
+var transactsqlStandardOnelineClausesPhrases = []string{
+	"CREATE TABLE",
+}
+
+var transactsqlTabularOnelineClausesPhrases = []string{
+	"CREATE [OR ALTER] [MATERIALIZED] VIEW",
+	"UPDATE",
+	"WHERE CURRENT OF",
+	"DELETE [FROM]",
+	"DROP TABLE [IF EXISTS]",
+	"ALTER TABLE",
+	"ADD",
+	"DROP COLUMN [IF EXISTS]",
+	"ALTER COLUMN",
+	"TRUNCATE TABLE",
+	"CREATE [UNIQUE] [CLUSTERED | NONCLUSTERED] INDEX",
+	"DROP INDEX [IF EXISTS]",
+	"CREATE [OR ALTER] {PROC | PROCEDURE}",
+	"ALTER {PROC | PROCEDURE}",
+	"DROP {PROC | PROCEDURE} [IF EXISTS]",
+	"CREATE [OR ALTER] FUNCTION",
+	"DROP FUNCTION [IF EXISTS]",
+	"CREATE [OR ALTER] TRIGGER",
+	"DROP TRIGGER [IF EXISTS]",
+	"DROP VIEW [IF EXISTS]",
+	"CREATE SCHEMA",
+	"DROP SCHEMA [IF EXISTS]",
+	"CREATE DATABASE",
+	"DROP DATABASE [IF EXISTS]",
+	"BULK INSERT",
+	"DECLARE",
+	"EXEC",
+	"EXECUTE",
+	"USE",
+	"PRINT",
+	"RAISERROR",
+	"THROW",
+	"RETURN",
+	"WAITFOR",
+	"BEGIN {TRAN | TRANSACTION}",
+	"COMMIT [TRAN | TRANSACTION]",
+	"ROLLBACK [TRAN | TRANSACTION]",
+	"SAVE {TRAN | TRANSACTION}",
+	"GRANT",
+	"REVOKE",
+	"DENY",
+	"OPEN",
+	"CLOSE",
+	"DEALLOCATE",
+	"FETCH {NEXT | PRIOR | FIRST | LAST} FROM",
+	"SET {ANSI_NULLS | ANSI_PADDING | ANSI_WARNINGS | ARITHABORT | CONCAT_NULL_YIELDS_NULL | DATEFIRST | DATEFORMAT | DEADLOCK_PRIORITY | IDENTITY_INSERT | LOCK_TIMEOUT | NOCOUNT | NOEXEC | NUMERIC_ROUNDABORT | QUOTED_IDENTIFIER | ROWCOUNT | STATISTICS IO | STATISTICS TIME | STATISTICS XML | TEXTSIZE | TRANSACTION ISOLATION LEVEL | XACT_ABORT}",
+}
+
+var transactsqlReservedSetOperationsPhrases = []string{
+	"UNION [ALL]",
+	"EXCEPT",
+	"INTERSECT",
+}
+
+var transactsqlReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"{CROSS | OUTER} APPLY",
+}
+
+var transactsqlReservedKeywordPhrasesPhrases = []string{
+	"ON {UPDATE | DELETE} [SET NULL | SET DEFAULT]",
+	"{ROWS | RANGE} BETWEEN",
+	"WITH TIES",
+}
+
+var TransactsqlDialect = DialectOptions{
+	Name: "transactsql",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:          ExpandPhrases(transactsqlReservedSelectPhrases),
+		ReservedClauses:         append(append([]string{}, ExpandPhrases(transactsqlReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(transactsqlStandardOnelineClausesPhrases)...), ExpandPhrases(transactsqlTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:   ExpandPhrases(transactsqlReservedSetOperationsPhrases),
+		ReservedJoins:           ExpandPhrases(transactsqlReservedJoinsPhrases),
+		ReservedKeywordPhrases:  ExpandPhrases(transactsqlReservedKeywordPhrasesPhrases),
+		ReservedKeywords:        transactsql.Keywords,
+		ReservedDataTypes:       transactsql.DataTypes,
+		ReservedFunctionNames:   transactsql.Functions,
+		NestedBlockComments:     true,
+		StringTypes:             []QuoteType{PrefixedQuoteType{Quote: PlainQuoteType("''-qq"), Prefixes: []string{"N"}}},
+		IdentTypes:              []QuoteType{PlainQuoteType("\"\"-qq"), PlainQuoteType("[]")},
+		IdentChars:              &IdentChars{First: "#@", Rest: "#@$"},
+		ParamTypes:              &ParamTypes{Named: []string{"@"}, Quoted: []string{"@"}},
+		Operators:               []string{"%", "&", "|", "^", "~", "!<", "!>", "+=", "-=", "*=", "/=", "%=", "|=", "&=", "^=", "::", ":"},
+		PropertyAccessOperators: []string{".."},
+		PostProcess:             transactsqlPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		AlwaysDenseOperators:  []string{"::"},
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(transactsqlStandardOnelineClausesPhrases)...), ExpandPhrases(transactsqlTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(transactsqlTabularOnelineClausesPhrases),
+	},
+}
+
+func transactsqlPostProcess(tokens []Token) []Token {
+	return detectBatchSeparators(disambiguateOutput(tokens))
+}
+
+// disambiguateOutput keeps OUTPUT as a clause only when it introduces a column
+// list (OUTPUT inserted.id ...). The OUTPUT marker on procedure parameters and
+// EXEC arguments is an ordinary keyword.
+func disambiguateOutput(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenReservedClause || token.Text != "OUTPUT" {
+			continue
+		}
+		next := nextNonCommentToken(tokens, i)
+		if next.Type != TokenIdentifier && next.Type != TokenQuotedIdentifier {
+			tokens[i].Type = TokenReservedKeyword
+		}
+	}
+	return tokens
+}
+
+// detectBatchSeparators turns GO on a line of its own (optionally followed by
+// a repeat count) into a batch separator, which ends the current statement
+// just like a semicolon does.
+func detectBatchSeparators(tokens []Token) []Token {
+	processed := make([]Token, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if !strings.EqualFold(token.Raw, "GO") || !startsLine(token, i) {
+			processed = append(processed, token)
+			continue
+		}
+		end := i
+		if end+1 < len(tokens) && tokens[end+1].Type == TokenNumber && !startsLine(tokens[end+1], end+1) {
+			end++
+		}
+		// comments may follow GO on its line
+		next := end + 1
+		for next < len(tokens) && isComment(tokens[next]) && !startsLine(tokens[next], next) {
+			next++
+		}
+		if next < len(tokens) && !startsLine(tokens[next], next) {
+			processed = append(processed, token)
+			continue
+		}
+		separator := Token{
+			Type:                TokenBatchSeparator,
+			Raw:                 token.Raw,
+			Text:                "GO",
+			Start:               token.Start,
+			End:                 token.End,
+			PrecedingWhitespace: token.PrecedingWhitespace,
+		}
+		if end > i {
+			separator.Raw += " " + tokens[end].Raw
+			separator.Text += " " + tokens[end].Text
+			separator.End = tokens[end].End
+		}
+		processed = append(processed, separator)
+		i = end
+	}
+	return processed
+}
+
+func startsLine(token Token, index int) bool {
+	return index == 0 || strings.ContainsAny(token.PrecedingWhitespace, "\r\n")
+}
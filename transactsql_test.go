@@ -0,0 +1,165 @@
+package sqlformatter
+
+import "testing"
+
+func TestTransactsqlFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageTransactsql, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageTransactsql, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{NestedBlockComments: true})
+	supportsCreateView(t, format, createViewConfig{Materialized: true})
+	supportsCreateTable(t, format, createTableConfig{})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsConstraints(t, format, []string{"CASCADE", "SET NULL", "SET DEFAULT", "NO ACTION"})
+	supportsAlterTable(t, format, alterTableConfig{DropColumn: true})
+	supportsDeleteFrom(t, format, deleteFromConfig{WithoutFrom: true})
+	supportsInsertInto(t, format, insertIntoConfig{WithoutInto: true})
+	supportsUpdate(t, format, updateConfig{WhereCurrentOf: true})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "N''"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq", "[]"})
+	supportsBetween(t, format)
+	supportsJoin(t, format, joinOptions{Without: []string{"NATURAL"}, SupportsApply: true})
+	supportsSetOperations(t, format, []string{"UNION", "UNION ALL", "EXCEPT", "INTERSECT"})
+	supportsOperators(t, format, []string{"%", "&", "|", "^", "~", "!<", "!>", "+=", "-=", "*=", "/=", "%=", "|=", "&=", "^="}, operatorConfig{Any: true})
+	supportsParams(t, format, paramConfig{Named: []string{"@"}, Quoted: []string{"@"}})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Offset: true, FetchFirst: true, FetchNext: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("treats GO on its own line as a batch separator", func(t *testing.T) {
+		result := format("USE mydb\nGO\nCREATE TABLE t (id INT)\ngo\nSELECT * FROM t;\nGO")
+		expected := dedent(`
+			USE mydb
+			GO
+
+			CREATE TABLE t (id INT)
+			go
+
+			SELECT
+			  *
+			FROM
+			  t;
+			GO
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports GO with a repeat count", func(t *testing.T) {
+		result := format("PRINT 'done'\nGO 5")
+		expected := dedent(`
+			PRINT 'done'
+			GO 5
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("treats GO followed by a comment as a batch separator", func(t *testing.T) {
+		result := format("PRINT 'a'\nGO -- end of batch\nPRINT 'b'\nGO 2 /* twice */\nPRINT 'c'")
+		expected := dedent(`
+			PRINT 'a'
+			GO
+			-- end of batch
+			PRINT 'b'
+			GO 2
+
+			/* twice */
+			PRINT 'c'
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("does not treat GO inside a statement as a batch separator", func(t *testing.T) {
+		result := format("SELECT go FROM t WHERE go = 1")
+		expected := dedent(`
+			SELECT
+			  go
+			FROM
+			  t
+			WHERE
+			  go = 1
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports TOP", func(t *testing.T) {
+		result := format("SELECT TOP (10) WITH TIES id, name FROM t ORDER BY id")
+		expected := dedent(`
+			SELECT
+			  TOP (10) WITH TIES id,
+			  name
+			FROM
+			  t
+			ORDER BY
+			  id
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports @local and @@global variables", func(t *testing.T) {
+		result := format("SELECT @total = COUNT(*), @@ROWCOUNT, @@IDENTITY FROM #tmp")
+		expected := dedent(`
+			SELECT
+			  @total = COUNT(*),
+			  @@ROWCOUNT,
+			  @@IDENTITY
+			FROM
+			  #tmp
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats OUTPUT clause", func(t *testing.T) {
+		result := format("INSERT INTO t (a) OUTPUT inserted.id, inserted.a INTO @ids VALUES (1); DELETE FROM t OUTPUT deleted.* WHERE id = @id;")
+		expected := dedent(`
+			INSERT INTO
+			  t (a)
+			OUTPUT
+			  inserted.id,
+			  inserted.a
+			INTO
+			  @ids
+			VALUES
+			  (1);
+
+			DELETE FROM t
+			OUTPUT
+			  deleted.*
+			WHERE
+			  id = @id;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("keeps OUTPUT parameter marker as a keyword", func(t *testing.T) {
+		result := format("EXEC dbo.p @a = 1, @b = @c OUTPUT;")
+		expected := dedent(`
+			EXEC dbo.p @a = 1,
+			@b = @c OUTPUT;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports SET options", func(t *testing.T) {
+		result := format("SET NOCOUNT ON; SET TRANSACTION ISOLATION LEVEL READ COMMITTED;")
+		expected := dedent(`
+			SET NOCOUNT ON;
+
+			SET TRANSACTION ISOLATION LEVEL READ COMMITTED;
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats :: scope resolution operator without spaces", func(t *testing.T) {
+		assertEqual(t, format("SELECT hierarchyid::GetRoot()"), dedent(`
+			SELECT
+			  hierarchyid::GetRoot ()
+		`))
+	})
+}
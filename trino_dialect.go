@@ -0,0 +1,156 @@
+package sqlformatter
+
+import (
+	"strings"
+
+	trino "sql-formatter-go/languages/trino"
+)
+
+var trinoReservedSelectPhrases = []string{
+	"SELECT [ALL | DISTINCT]",
+}
+
+var trinoReservedClausesPhrases = []string{
+	"WITH [RECURSIVE]",
+	"FROM",
+	"WHERE",
+	"GROUP BY [ALL | DISTINCT]",
+	"HAVING",
+	"WINDOW",
+	"PARTITION BY",
+	"ORDER BY",
+	"LIMIT",
+	"OFFSET",
+	"FETCH {FIRST | NEXT}",
+	"INSERT INTO",
+	"VALUES",
+	"SET",
+	"MERGE INTO",
+	"WHEN [NOT] MATCHED [AND]",
+	"THEN {UPDATE SET | DELETE | INSERT}",
+	// MATCH_RECOGNIZE sub-clauses
+	"MEASURES",
+	"{ONE ROW | ALL ROWS} PER MATCH",
+	"AFTER MATCH SKIP",
+	"PATTERN",
+	"SUBSET",
+	"DEFINE",
+}
+
+var trinoStandardOnelineClausesPhrases = []string{
+	"CREATE TABLE [IF NOT EXISTS]",
+}
+
+var trinoTabularOnelineClausesPhrases = []string{
+	"CREATE [OR REPLACE] [MATERIALIZED] VIEW",
+	"UPDATE",
+	"DELETE FROM",
+	"DROP TABLE [IF EXISTS]",
+	"ALTER TABLE [IF EXISTS]",
+	"ADD COLUMN [IF NOT EXISTS]",
+	"DROP COLUMN [IF EXISTS]",
+	"RENAME COLUMN [IF EXISTS]",
+	"RENAME TO",
+	"SET AUTHORIZATION",
+	"SET PROPERTIES",
+	"EXECUTE",
+	"TRUNCATE TABLE",
+	"ALTER SCHEMA",
+	"ALTER MATERIALIZED VIEW",
+	"ALTER VIEW",
+	"CREATE SCHEMA",
+	"CREATE ROLE",
+	"DROP SCHEMA",
+	"DROP MATERIALIZED VIEW",
+	"DROP VIEW",
+	"DROP ROLE",
+	"EXPLAIN",
+	"EXPLAIN ANALYZE",
+	"EXECUTE IMMEDIATE",
+	"DESCRIBE INPUT",
+	"DESCRIBE OUTPUT",
+	"REFRESH MATERIALIZED VIEW",
+	"RESET SESSION",
+	"SET SESSION",
+	"SET PATH",
+	"SET TIME ZONE",
+	"SHOW GRANTS",
+	"SHOW CREATE TABLE",
+	"SHOW CREATE SCHEMA",
+	"SHOW CREATE VIEW",
+	"SHOW CREATE MATERIALIZED VIEW",
+	"SHOW TABLES",
+	"SHOW SCHEMAS",
+	"SHOW CATALOGS",
+	"SHOW COLUMNS",
+	"SHOW STATS FOR",
+	"SHOW ROLES",
+	"SHOW CURRENT ROLES",
+	"SHOW ROLE GRANTS",
+	"SHOW FUNCTIONS",
+	"SHOW SESSION",
+	"USE",
+}
+
+var trinoReservedSetOperationsPhrases = []string{
+	"UNION [ALL | DISTINCT]",
+	"EXCEPT [ALL | DISTINCT]",
+	"INTERSECT [ALL | DISTINCT]",
+}
+
+var trinoReservedJoinsPhrases = []string{
+	"JOIN",
+	"{LEFT | RIGHT | FULL} [OUTER] JOIN",
+	"{INNER | CROSS} JOIN",
+	"NATURAL [INNER] JOIN",
+	"NATURAL {LEFT | RIGHT | FULL} [OUTER] JOIN",
+}
+
+var trinoReservedKeywordPhrasesPhrases = []string{
+	"{ROWS | RANGE | GROUPS} BETWEEN",
+	"IS [NOT] DISTINCT FROM",
+	"WITH TIES",
+	"WITH [NO] DATA",
+}
+
+var TrinoDialect = DialectOptions{
+	Name: "trino",
+	TokenizerOptions: TokenizerOptions{
+		ReservedSelect:         ExpandPhrases(trinoReservedSelectPhrases),
+		ReservedClauses:        append(append([]string{}, ExpandPhrases(trinoReservedClausesPhrases)...), append(append([]string{}, ExpandPhrases(trinoStandardOnelineClausesPhrases)...), ExpandPhrases(trinoTabularOnelineClausesPhrases)...)...),
+		ReservedSetOperations:  ExpandPhrases(trinoReservedSetOperationsPhrases),
+		ReservedJoins:          ExpandPhrases(trinoReservedJoinsPhrases),
+		ReservedKeywordPhrases: ExpandPhrases(trinoReservedKeywordPhrasesPhrases),
+		ReservedKeywords:       trino.Keywords,
+		ReservedDataTypes:      trino.DataTypes,
+		ReservedFunctionNames:  trino.Functions,
+		ExtraParens:            []string{"[]", "{}"},
+		StringTypes: []QuoteType{
+			PrefixedQuoteType{Quote: PlainQuoteType("''-qq"), Prefixes: []string{"U&"}},
+			PrefixedQuoteType{Quote: PlainQuoteType("''-raw"), Prefixes: []string{"X"}, RequirePrefix: true},
+		},
+		IdentTypes:  []QuoteType{PlainQuoteType("\"\"-qq")},
+		ParamTypes:  &ParamTypes{Positional: true},
+		Operators:   []string{"%", "->", "=>", ":", "||", "|", "^"},
+		PostProcess: trinoPostProcess,
+	},
+	FormatOptions: DialectFormatOptions{
+		OnelineClauses:        append(append([]string{}, ExpandPhrases(trinoStandardOnelineClausesPhrases)...), ExpandPhrases(trinoTabularOnelineClausesPhrases)...),
+		TabularOnelineClauses: ExpandPhrases(trinoTabularOnelineClausesPhrases),
+	},
+}
+
+// trinoPostProcess keeps the WITH of UNNEST(...) WITH ORDINALITY as an
+// ordinary keyword, so that it stays on the line of the UNNEST rather than
+// starting a WITH clause.
+func trinoPostProcess(tokens []Token) []Token {
+	for i, token := range tokens {
+		if token.Type != TokenReservedClause || token.Text != "WITH" {
+			continue
+		}
+		if next := nextNonCommentToken(tokens, i); strings.ToUpper(next.Text) == "ORDINALITY" {
+			tokens[i].Type = TokenReservedKeyword
+		}
+	}
+	return tokens
+}
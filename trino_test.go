@@ -0,0 +1,130 @@
+package sqlformatter
+
+import "testing"
+
+func TestTrinoFormatter(t *testing.T) {
+	format := func(query string, cfg ...FormatOptions) string {
+		t.Helper()
+		return formatLanguage(t, LanguageTrino, query, cfg...)
+	}
+	formatErr := func(query string, cfg ...FormatOptions) error {
+		return formatLanguageErr(LanguageTrino, query, cfg...)
+	}
+
+	behavesLikeSqlFormatter(t, format)
+	supportsComments(t, format, commentsConfig{})
+	supportsCreateView(t, format, createViewConfig{OrReplace: true, Materialized: true})
+	supportsCreateTable(t, format, createTableConfig{IfNotExists: true})
+	supportsDropTable(t, format, dropTableConfig{IfExists: true})
+	supportsAlterTable(t, format, alterTableConfig{AddColumn: true, DropColumn: true, RenameTo: true, RenameColumn: true})
+	supportsDeleteFrom(t, format)
+	supportsInsertInto(t, format)
+	supportsUpdate(t, format, updateConfig{})
+	supportsTruncateTable(t, format, truncateTableConfig{WithTable: true})
+	supportsStrings(t, format, formatErr, []string{"''-qq", "X''", "U&''"})
+	supportsIdentifiers(t, format, formatErr, []string{"\"\"-qq"})
+	supportsArrayAndMapAccessors(t, format)
+	supportsArrayLiterals(t, format, arrayLiteralConfig{WithArrayPrefix: true})
+	supportsBetween(t, format)
+	supportsIsDistinctFrom(t, format)
+	supportsJoin(t, format, joinOptions{SupportsUsing: true})
+	supportsSetOperations(t, format)
+	supportsOperators(t, format, []string{"%", "->", "=>", "||"}, operatorConfig{Any: true})
+	supportsParams(t, format, paramConfig{Positional: true})
+	supportsWindow(t, format)
+	supportsLimiting(t, format, limitingConfig{Limit: true, Offset: true, FetchFirst: true, FetchNext: true})
+	supportsDataTypeCase(t, format)
+
+	t.Run("supports catalog.schema.table names", func(t *testing.T) {
+		result := format("SELECT e.id FROM hive.web.events e JOIN postgresql.public.users u ON e.uid = u.id")
+		expected := dedent(`
+			SELECT
+			  e.id
+			FROM
+			  hive.web.events e
+			  JOIN postgresql.public.users u ON e.uid = u.id
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports ROW and MAP constructors and lambdas", func(t *testing.T) {
+		result := format("SELECT ROW(1, 'a'), MAP(ARRAY['a'], ARRAY[1]), transform(arr, x -> x + 1)")
+		expected := dedent(`
+			SELECT
+			  ROW(1, 'a'),
+			  MAP(ARRAY['a'], ARRAY[1]),
+			  transform(arr, x -> x + 1)
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats UNNEST WITH ORDINALITY", func(t *testing.T) {
+		result := format("SELECT tag, pos FROM events CROSS JOIN UNNEST(tags) WITH ORDINALITY AS t(tag, pos)")
+		expected := dedent(`
+			SELECT
+			  tag,
+			  pos
+			FROM
+			  events
+			  CROSS JOIN UNNEST(tags) WITH ORDINALITY AS t (tag, pos)
+		`)
+		assertEqual(t, result, expected)
+
+		result = format("select tag from events cross join unnest(tags) with ordinality")
+		expected = dedent(`
+			select
+			  tag
+			from
+			  events
+			  cross join unnest(tags) with ordinality
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("formats FETCH FIRST WITH TIES", func(t *testing.T) {
+		result := format("SELECT * FROM t ORDER BY score FETCH FIRST 10 ROWS WITH TIES")
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  t
+			ORDER BY
+			  score
+			FETCH FIRST
+			  10 ROWS WITH TIES
+		`)
+		assertEqual(t, result, expected)
+	})
+
+	t.Run("supports MATCH_RECOGNIZE", func(t *testing.T) {
+		result := format(`SELECT * FROM orders MATCH_RECOGNIZE (
+			PARTITION BY custkey ORDER BY orderdate
+			MEASURES A.totalprice AS starting_price
+			ONE ROW PER MATCH
+			AFTER MATCH SKIP PAST LAST ROW
+			PATTERN (A B+ C+)
+			DEFINE B AS totalprice < PREV(totalprice), C AS totalprice > PREV(totalprice))`)
+		expected := dedent(`
+			SELECT
+			  *
+			FROM
+			  orders MATCH_RECOGNIZE (
+			    PARTITION BY
+			      custkey
+			    ORDER BY
+			      orderdate
+			    MEASURES
+			      A.totalprice AS starting_price
+			    ONE ROW PER MATCH
+			    AFTER MATCH SKIP
+			      PAST LAST ROW
+			    PATTERN
+			      (A B + C +)
+			    DEFINE
+			      B AS totalprice < PREV(totalprice),
+			      C AS totalprice > PREV(totalprice)
+			  )
+		`)
+		assertEqual(t, result, expected)
+	})
+}
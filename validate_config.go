@@ -16,6 +16,10 @@ func validateConfig(cfg FormatOptions) (FormatOptions, error) {
 		return cfg, ConfigError{Message: fmt.Sprintf("expressionWidth config must be positive number. Received %d instead.", cfg.ExpressionWidth)}
 	}
 
+	if err := validateLimits(cfg.Limits); err != nil {
+		return cfg, err
+	}
+
 	if cfg.Params != nil {
 		if !validateParams(cfg.Params) {
 			// warning only in JS; ignore here